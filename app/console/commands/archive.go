@@ -0,0 +1,332 @@
+package commands
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/goravel/framework/errors"
+)
+
+const (
+	transportGit     = "git"
+	transportArchive = "archive"
+)
+
+var transports = []string{transportGit, transportArchive}
+
+var githubRepoRegexp = regexp.MustCompile(`^(?:https?://|ssh://git@|git@)github\.com[/:]([\w.-]+)/([\w.-]+?)(?:\.git)?/?$`)
+
+// gitInstalled Report whether the git binary is available, it can be replaced in tests.
+var gitInstalled = func() bool {
+	_, err := exec.LookPath("git")
+
+	return err == nil
+}
+
+// archiveHTTPClient The HTTP client used to download archives.
+var archiveHTTPClient = &http.Client{Timeout: 5 * time.Minute}
+
+// resolveTransport Validate the --transport value, git is used when it's available and archive otherwise.
+func resolveTransport(transport string) (string, error) {
+	if transport == "" {
+		if gitInstalled() {
+			return transportGit, nil
+		}
+
+		return transportArchive, nil
+	}
+
+	if !slices.Contains(transports, transport) {
+		return "", fmt.Errorf("invalid transport %q, use one of: %s", transport, strings.Join(transports, ", "))
+	}
+
+	return transport, nil
+}
+
+// archiveURL Get the URL of the .tar.gz snapshot of a repository at a ref, an empty ref means the default branch.
+// The snapshots are only downloaded from GitHub, so the mirrors can't be set.
+func archiveURL(repo, ref string, mirrors []string) (string, error) {
+	matches := githubRepoRegexp.FindStringSubmatch(repo)
+	if matches == nil {
+		return "", fmt.Errorf("unable to download %s as an archive, only GitHub repositories are supported, install git or use an archive URL as the template", repo)
+	}
+	if err := checkArchiveMirrors(mirrors); err != nil {
+		return "", err
+	}
+	if ref == "" {
+		ref = "HEAD"
+	}
+
+	return fmt.Sprintf("https://github.com/%s/%s/archive/%s.tar.gz", matches[1], matches[2], url.PathEscape(ref)), nil
+}
+
+// downloadArchive Download an archive to a temp file and verify it against the SHA-256 checksum when one is given.
+// The download is stopped once the context is cancelled. The caller is responsible for removing the returned file.
+func downloadArchive(ctx context.Context, archiveURL, checksum string) (string, error) {
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, archiveURL, nil)
+	if err != nil {
+		return "", err
+	}
+
+	response, err := archiveHTTPClient.Do(request)
+	if err != nil {
+		return "", err
+	}
+	defer errors.Ignore(response.Body.Close)
+
+	if response.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("unexpected status %s from %s", response.Status, archiveURL)
+	}
+
+	extension := ".tar.gz"
+	if parsed, err := url.Parse(archiveURL); err == nil && isArchive(parsed.Path) {
+		extension = archiveExtension(parsed.Path)
+	}
+
+	out, err := os.CreateTemp("", "goravel-archive-*"+extension)
+	if err != nil {
+		return "", err
+	}
+	defer errors.Ignore(out.Close)
+
+	hash := sha256.New()
+	if _, err := io.Copy(io.MultiWriter(out, hash), response.Body); err != nil {
+		_ = os.Remove(out.Name())
+		return "", err
+	}
+
+	if err := compareChecksum(hex.EncodeToString(hash.Sum(nil)), checksum); err != nil {
+		_ = os.Remove(out.Name())
+		return "", err
+	}
+
+	return out.Name(), nil
+}
+
+// verifyFileChecksum Verify a local file against the SHA-256 checksum.
+func verifyFileChecksum(path, checksum string) error {
+	actual, err := fileChecksum(path)
+	if err != nil {
+		return err
+	}
+
+	return compareChecksum(actual, checksum)
+}
+
+// fileChecksum Get the hex-encoded SHA-256 checksum of a file.
+func fileChecksum(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer errors.Ignore(f.Close)
+
+	hash := sha256.New()
+	if _, err := io.Copy(hash, f); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+func compareChecksum(actual, expected string) error {
+	expected = strings.ToLower(strings.TrimPrefix(strings.TrimSpace(expected), "sha256:"))
+	if expected != "" && actual != expected {
+		return fmt.Errorf("checksum mismatch, expected sha256 %s but got %s", expected, actual)
+	}
+
+	return nil
+}
+
+// unpackArchive Extract a .tar.gz, .tgz, .tar or .zip archive to the destination. When every entry of the
+// archive lives under a single top-level directory, that directory is stripped.
+func unpackArchive(archive, destination string) error {
+	tmpDir, err := os.MkdirTemp("", "goravel-archive-*")
+	if err != nil {
+		return fmt.Errorf("failed to create temp directory: %w", err)
+	}
+	defer func() {
+		_ = os.RemoveAll(tmpDir)
+	}()
+
+	if strings.HasSuffix(archive, ".zip") {
+		err = extractZip(archive, tmpDir)
+	} else {
+		err = extractTarball(archive, tmpDir)
+	}
+	if err != nil {
+		return err
+	}
+
+	root := tmpDir
+	entries, err := os.ReadDir(tmpDir)
+	if err != nil {
+		return err
+	}
+	if len(entries) == 1 && entries[0].IsDir() {
+		root = filepath.Join(tmpDir, entries[0].Name())
+	}
+
+	return copyDirectory(root, destination)
+}
+
+func extractTarball(archive, destination string) error {
+	f, err := os.Open(archive)
+	if err != nil {
+		return err
+	}
+	defer errors.Ignore(f.Close)
+
+	var reader io.Reader = f
+	if !strings.HasSuffix(archive, ".tar") {
+		gzipReader, err := gzip.NewReader(f)
+		if err != nil {
+			return err
+		}
+		defer errors.Ignore(gzipReader.Close)
+
+		reader = gzipReader
+	}
+
+	tarReader := tar.NewReader(reader)
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		switch header.Typeflag {
+		case tar.TypeXGlobalHeader:
+			continue
+		case tar.TypeDir, tar.TypeReg:
+		default:
+			return fmt.Errorf("unsupported archive entry %q", header.Name)
+		}
+
+		target, err := archiveEntryPath(destination, header.Name)
+		if err != nil {
+			return err
+		}
+
+		if header.Typeflag == tar.TypeDir {
+			if err := os.MkdirAll(target, 0755); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if err := writeArchiveEntry(target, tarReader, header.FileInfo().Mode()); err != nil {
+			return err
+		}
+	}
+}
+
+func extractZip(archive, destination string) error {
+	reader, err := zip.OpenReader(archive)
+	if err != nil {
+		return err
+	}
+	defer errors.Ignore(reader.Close)
+
+	for _, entry := range reader.File {
+		target, err := archiveEntryPath(destination, entry.Name)
+		if err != nil {
+			return err
+		}
+
+		mode := entry.Mode()
+		if mode.IsDir() {
+			if err := os.MkdirAll(target, 0755); err != nil {
+				return err
+			}
+			continue
+		}
+		if !mode.IsRegular() {
+			return fmt.Errorf("unsupported archive entry %q", entry.Name)
+		}
+
+		if err := extractZipEntry(entry, target); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func extractZipEntry(entry *zip.File, target string) error {
+	in, err := entry.Open()
+	if err != nil {
+		return err
+	}
+	defer errors.Ignore(in.Close)
+
+	return writeArchiveEntry(target, in, entry.Mode())
+}
+
+// archiveEntryPath Resolve an archive entry inside the destination, rejecting entries that would escape it.
+func archiveEntryPath(destination, name string) (string, error) {
+	if filepath.IsAbs(name) || path.IsAbs(name) || filepath.VolumeName(name) != "" {
+		return "", fmt.Errorf("illegal archive entry %q", name)
+	}
+
+	target := filepath.Join(destination, filepath.FromSlash(name))
+	relativePath, err := filepath.Rel(destination, target)
+	if err != nil || relativePath == ".." || strings.HasPrefix(relativePath, ".."+string(filepath.Separator)) {
+		return "", fmt.Errorf("illegal archive entry %q", name)
+	}
+
+	return target, nil
+}
+
+func writeArchiveEntry(target string, reader io.Reader, mode os.FileMode) error {
+	if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+		return err
+	}
+
+	out, err := os.OpenFile(target, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, mode.Perm()|0200)
+	if err != nil {
+		return err
+	}
+	defer errors.Ignore(out.Close)
+
+	_, err = io.Copy(out, reader)
+
+	return err
+}
+
+func archiveExtension(path string) string {
+	for _, extension := range []string{".tar.gz", ".tgz", ".tar", ".zip"} {
+		if strings.HasSuffix(path, extension) {
+			return extension
+		}
+	}
+
+	return ""
+}
+
+func isArchive(path string) bool {
+	return archiveExtension(path) != ""
+}
+
+func isHTTPURL(location string) bool {
+	return strings.HasPrefix(location, "http://") || strings.HasPrefix(location, "https://")
+}
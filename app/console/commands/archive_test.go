@@ -0,0 +1,229 @@
+package commands
+
+import (
+	"archive/zip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResolveTransport(t *testing.T) {
+	setGitInstalled(t, true)
+	transport, err := resolveTransport("")
+	assert.Nil(t, err)
+	assert.Equal(t, transportGit, transport)
+
+	setGitInstalled(t, false)
+	transport, err = resolveTransport("")
+	assert.Nil(t, err)
+	assert.Equal(t, transportArchive, transport)
+
+	transport, err = resolveTransport("git")
+	assert.Nil(t, err)
+	assert.Equal(t, transportGit, transport)
+
+	_, err = resolveTransport("svn")
+	assert.ErrorContains(t, err, `invalid transport "svn", use one of: git, archive`)
+}
+
+func TestArchiveURL(t *testing.T) {
+	tests := []struct {
+		repo     string
+		ref      string
+		mirrors  []string
+		expected string
+		err      string
+	}{
+		{repo: "https://github.com/goravel/goravel.git", expected: "https://github.com/goravel/goravel/archive/HEAD.tar.gz"},
+		{repo: "https://github.com/goravel/goravel-lite", ref: "master", expected: "https://github.com/goravel/goravel-lite/archive/master.tar.gz"},
+		{repo: "git@github.com:goravel/agents.git", ref: "v1.0.0", expected: "https://github.com/goravel/agents/archive/v1.0.0.tar.gz"},
+		{repo: "https://github.com/goravel/goravel.git", mirrors: []string{githubMirror}, expected: "https://github.com/goravel/goravel/archive/HEAD.tar.gz"},
+		{repo: "https://github.com/goravel/goravel.git", mirrors: []string{"https://gitee.com/"}, err: "unable to download an archive from the mirror https://gitee.com/, install git or use the git transport"},
+		{repo: "file:///srv/git/skeleton.git", err: "only GitHub repositories are supported"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.repo, func(t *testing.T) {
+			archive, err := archiveURL(test.repo, test.ref, test.mirrors)
+			if test.err != "" {
+				assert.ErrorContains(t, err, test.err)
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, test.expected, archive)
+		})
+	}
+}
+
+func TestDownloadArchive(t *testing.T) {
+	archive := filepath.Join(t.TempDir(), "skeleton.tar.gz")
+	writeTarball(t, archive, map[string]string{"skeleton/go.mod": "module goravel\n"})
+	content, err := os.ReadFile(archive)
+	assert.Nil(t, err)
+	sum := sha256.Sum256(content)
+	checksum := hex.EncodeToString(sum[:])
+
+	serveArchives(t, map[string][]byte{"/goravel/goravel/archive/HEAD.tar.gz": content})
+
+	t.Run("with a valid checksum", func(t *testing.T) {
+		downloaded, err := downloadArchive(context.Background(), "https://github.com/goravel/goravel/archive/HEAD.tar.gz", "sha256:"+checksum)
+		assert.Nil(t, err)
+		defer func() {
+			_ = os.Remove(downloaded)
+		}()
+
+		assert.Equal(t, ".tar.gz", archiveExtension(downloaded))
+		downloadedContent, err := os.ReadFile(downloaded)
+		assert.Nil(t, err)
+		assert.Equal(t, content, downloadedContent)
+	})
+
+	t.Run("with an invalid checksum", func(t *testing.T) {
+		_, err := downloadArchive(context.Background(), "https://github.com/goravel/goravel/archive/HEAD.tar.gz", "abc")
+		assert.ErrorContains(t, err, "checksum mismatch, expected sha256 abc but got "+checksum)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		_, err := downloadArchive(context.Background(), "https://github.com/goravel/missing/archive/HEAD.tar.gz", "")
+		assert.ErrorContains(t, err, "unexpected status 404 Not Found")
+	})
+
+	t.Run("with a cancelled context", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		_, err := downloadArchive(ctx, "https://github.com/goravel/goravel/archive/HEAD.tar.gz", "")
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+}
+
+func TestUnpackArchive(t *testing.T) {
+	t.Run("zip", func(t *testing.T) {
+		archive := filepath.Join(t.TempDir(), "skeleton.zip")
+		writeZip(t, archive, map[string]string{
+			"skeleton-master/go.mod":     "module goravel\n",
+			"skeleton-master/app/app.go": "package app",
+		})
+
+		destination := filepath.Join(t.TempDir(), "project")
+		assert.Nil(t, unpackArchive(archive, destination))
+		assert.FileExists(t, filepath.Join(destination, "go.mod"))
+		assert.FileExists(t, filepath.Join(destination, "app", "app.go"))
+	})
+
+	t.Run("zip with path traversal", func(t *testing.T) {
+		archive := filepath.Join(t.TempDir(), "skeleton.zip")
+		writeZip(t, archive, map[string]string{"../../evil.go": "package evil"})
+
+		destination := filepath.Join(t.TempDir(), "project")
+		assert.ErrorContains(t, unpackArchive(archive, destination), `illegal archive entry "../../evil.go"`)
+		assert.NoDirExists(t, destination)
+	})
+
+	t.Run("tarball with absolute path", func(t *testing.T) {
+		archive := filepath.Join(t.TempDir(), "skeleton.tar.gz")
+		writeTarball(t, archive, map[string]string{"/etc/evil": "evil"})
+
+		destination := filepath.Join(t.TempDir(), "project")
+		assert.ErrorContains(t, unpackArchive(archive, destination), `illegal archive entry "/etc/evil"`)
+	})
+}
+
+func TestFetchTemplateWithArchiveTransport(t *testing.T) {
+	isolateUserDirs(t)
+	archive := filepath.Join(t.TempDir(), "goravel.tar.gz")
+	writeTarball(t, archive, map[string]string{
+		"goravel-master/go.mod":       "module goravel\n",
+		"goravel-master/.env.example": "APP_NAME=Goravel\n",
+	})
+	content, err := os.ReadFile(archive)
+	assert.Nil(t, err)
+	serveArchives(t, map[string][]byte{"/goravel/goravel/archive/master.tar.gz": content})
+
+	repo := "https://github.com/goravel/goravel.git"
+	path := filepath.Join(t.TempDir(), "project")
+	err = (&NewCommand{}).fetchTemplate(context.Background(), templateSource{Kind: templateKindGit, Location: repo}, path, templateFetchOptions{
+		Ref:       "master",
+		Transport: transportArchive,
+	})
+	assert.Nil(t, err)
+	assert.FileExists(t, filepath.Join(path, "go.mod"))
+	assert.FileExists(t, filepath.Join(path, ".env.example"))
+
+	_, cached := readTemplateCache(repo, "master")
+	assert.True(t, cached)
+}
+
+// serveArchives Serve the archives from a local HTTP server, every archive request is routed to it.
+func serveArchives(t *testing.T, archives map[string][]byte) {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		content, ok := archives[r.URL.Path]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+
+		_, _ = w.Write(content)
+	}))
+	t.Cleanup(server.Close)
+
+	serverURL, err := url.Parse(server.URL)
+	if err != nil {
+		t.Fatalf("url.Parse(%q) = %v, want nil", server.URL, err)
+	}
+
+	original := archiveHTTPClient
+	archiveHTTPClient = &http.Client{Transport: roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		r = r.Clone(r.Context())
+		r.URL.Scheme = serverURL.Scheme
+		r.URL.Host = serverURL.Host
+
+		return http.DefaultTransport.RoundTrip(r)
+	})}
+	t.Cleanup(func() {
+		archiveHTTPClient = original
+	})
+}
+
+type roundTripperFunc func(r *http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
+
+func writeZip(t *testing.T, path string, files map[string]string) {
+	t.Helper()
+
+	f, err := os.Create(path)
+	if err != nil {
+		t.Fatalf("os.Create(%q) = %v, want nil", path, err)
+	}
+	defer func() {
+		_ = f.Close()
+	}()
+
+	zipWriter := zip.NewWriter(f)
+	for name, content := range files {
+		writer, err := zipWriter.Create(name)
+		if err != nil {
+			t.Fatalf("zipWriter.Create(%q) = %v, want nil", name, err)
+		}
+		if _, err := writer.Write([]byte(content)); err != nil {
+			t.Fatalf("writer.Write(%q) = %v, want nil", name, err)
+		}
+	}
+	if err := zipWriter.Close(); err != nil {
+		t.Fatalf("zipWriter.Close() = %v, want nil", err)
+	}
+}
@@ -0,0 +1,52 @@
+package commands
+
+import (
+	"github.com/goravel/framework/contracts/console"
+	"github.com/goravel/framework/contracts/console/command"
+	"github.com/goravel/framework/support/color"
+	"github.com/goravel/framework/support/file"
+)
+
+type CacheClearCommand struct{}
+
+func NewCacheClearCommand() *CacheClearCommand {
+	return &CacheClearCommand{}
+}
+
+// Signature The name and signature of the console command.
+func (r *CacheClearCommand) Signature() string {
+	return "cache:clear"
+}
+
+// Description The console command description.
+func (r *CacheClearCommand) Description() string {
+	return "Clear the local template cache"
+}
+
+// Extend The console command extend.
+func (r *CacheClearCommand) Extend() command.Extend {
+	return command.Extend{}
+}
+
+// Handle Execute the console command.
+func (r *CacheClearCommand) Handle(ctx console.Context) error {
+	dir, err := templateCacheDir()
+	if err != nil {
+		color.Errorln(err)
+		return nil
+	}
+
+	if !file.Exists(dir) {
+		color.Warnln("The template cache is empty")
+		return nil
+	}
+
+	if err := file.Remove(dir); err != nil {
+		color.Errorf("Failed to clear the template cache: %s\n", err)
+		return nil
+	}
+
+	color.Successln("Cleared the template cache in " + dir)
+
+	return nil
+}
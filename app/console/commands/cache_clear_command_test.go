@@ -0,0 +1,34 @@
+package commands
+
+import (
+	"io"
+	"path/filepath"
+	"testing"
+
+	mocksconsole "github.com/goravel/framework/mocks/console"
+	"github.com/goravel/framework/support/color"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCacheClearCommand(t *testing.T) {
+	isolateUserDirs(t)
+	cacheClearCommand := NewCacheClearCommand()
+
+	source := t.TempDir()
+	writeFile(t, filepath.Join(source, "go.mod"), "module goravel\n")
+	assert.Nil(t, saveTemplateCache("https://github.com/goravel/goravel.git", "", source))
+
+	dir, err := templateCacheDir()
+	assert.Nil(t, err)
+
+	captureOutput := color.CaptureOutput(func(w io.Writer) {
+		assert.Nil(t, cacheClearCommand.Handle(mocksconsole.NewContext(t)))
+	})
+	assert.Contains(t, captureOutput, "Cleared the template cache in "+dir)
+	assert.NoDirExists(t, dir)
+
+	captureOutput = color.CaptureOutput(func(w io.Writer) {
+		assert.Nil(t, cacheClearCommand.Handle(mocksconsole.NewContext(t)))
+	})
+	assert.Contains(t, captureOutput, "The template cache is empty")
+}
@@ -0,0 +1,56 @@
+package commands
+
+import (
+	"github.com/goravel/framework/contracts/console"
+	"github.com/goravel/framework/contracts/console/command"
+	"github.com/goravel/framework/support/color"
+)
+
+type ConfigGetCommand struct{}
+
+func NewConfigGetCommand() *ConfigGetCommand {
+	return &ConfigGetCommand{}
+}
+
+// Signature The name and signature of the console command.
+func (r *ConfigGetCommand) Signature() string {
+	return "config:get"
+}
+
+// Description The console command description.
+func (r *ConfigGetCommand) Description() string {
+	return "Get a Goravel installer configuration value"
+}
+
+// Extend The console command extend.
+func (r *ConfigGetCommand) Extend() command.Extend {
+	return command.Extend{
+		ArgsUsage: " <key>",
+		Arguments: []command.Argument{
+			&command.ArgumentString{
+				Name:     "key",
+				Usage:    "The configuration key, e.g. new.type",
+				Required: true,
+			},
+		},
+	}
+}
+
+// Handle Execute the console command.
+func (r *ConfigGetCommand) Handle(ctx console.Context) error {
+	key, err := getInstallerConfigKey(ctx.ArgumentString("key"))
+	if err != nil {
+		color.Errorln(err)
+		return nil
+	}
+
+	config, err := loadInstallerConfig()
+	if err != nil {
+		color.Errorln(err)
+		return nil
+	}
+
+	color.Printfln("%s", config.Get(key.Name))
+
+	return nil
+}
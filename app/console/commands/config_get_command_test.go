@@ -0,0 +1,42 @@
+package commands
+
+import (
+	"io"
+	"testing"
+
+	mocksconsole "github.com/goravel/framework/mocks/console"
+	"github.com/goravel/framework/support/color"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfigGetCommand(t *testing.T) {
+	configGetCommand := NewConfigGetCommand()
+
+	t.Run("get a value", func(t *testing.T) {
+		isolateUserDirs(t)
+		t.Chdir(t.TempDir())
+		userPath, err := userInstallerConfigPath()
+		assert.Nil(t, err)
+		writeFile(t, userPath, "new:\n  type: lite\n")
+
+		mockContext := mocksconsole.NewContext(t)
+		mockContext.EXPECT().ArgumentString("key").Return("new.type").Once()
+
+		captureOutput := color.CaptureOutput(func(w io.Writer) {
+			assert.Nil(t, configGetCommand.Handle(mockContext))
+		})
+
+		assert.Equal(t, "lite\n", captureOutput)
+	})
+
+	t.Run("unknown key", func(t *testing.T) {
+		mockContext := mocksconsole.NewContext(t)
+		mockContext.EXPECT().ArgumentString("key").Return("unknown").Once()
+
+		captureOutput := color.CaptureOutput(func(w io.Writer) {
+			assert.Nil(t, configGetCommand.Handle(mockContext))
+		})
+
+		assert.Contains(t, captureOutput, `unknown config key "unknown"`)
+	})
+}
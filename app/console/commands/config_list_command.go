@@ -0,0 +1,60 @@
+package commands
+
+import (
+	"github.com/goravel/framework/contracts/console"
+	"github.com/goravel/framework/contracts/console/command"
+	"github.com/goravel/framework/support/color"
+)
+
+type ConfigListCommand struct{}
+
+func NewConfigListCommand() *ConfigListCommand {
+	return &ConfigListCommand{}
+}
+
+// Signature The name and signature of the console command.
+func (r *ConfigListCommand) Signature() string {
+	return "config:list"
+}
+
+// Description The console command description.
+func (r *ConfigListCommand) Description() string {
+	return "List the Goravel installer configuration"
+}
+
+// Extend The console command extend.
+func (r *ConfigListCommand) Extend() command.Extend {
+	return command.Extend{}
+}
+
+// Handle Execute the console command.
+func (r *ConfigListCommand) Handle(ctx console.Context) error {
+	config, err := loadInstallerConfig()
+	if err != nil {
+		color.Errorln(err)
+		return nil
+	}
+
+	userPath, err := userInstallerConfigPath()
+	if err != nil {
+		color.Errorln(err)
+		return nil
+	}
+
+	color.Green().Printfln("Goravel installer configuration:")
+	color.Printfln("User config: %s", userPath)
+	if localPath := findLocalInstallerConfig(); localPath != "" {
+		color.Printfln("Project config: %s", localPath)
+	}
+
+	for _, key := range installerConfigKeys {
+		color.Printfln("")
+		color.Printfln("%s = %s", key.Name, config.Get(key.Name))
+		color.Printfln("   Description: %s", key.Usage)
+		if source := config.Source(key.Name); source != "" {
+			color.Printfln("   Source: %s", source)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,29 @@
+package commands
+
+import (
+	"io"
+	"path/filepath"
+	"testing"
+
+	mocksconsole "github.com/goravel/framework/mocks/console"
+	"github.com/goravel/framework/support/color"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfigListCommand(t *testing.T) {
+	isolateUserDirs(t)
+	dir := t.TempDir()
+	t.Chdir(dir)
+	localPath := filepath.Join(dir, localInstallerConfigFile)
+	writeFile(t, localPath, "skill:\n  path: ~/skills\n")
+
+	captureOutput := color.CaptureOutput(func(w io.Writer) {
+		assert.Nil(t, NewConfigListCommand().Handle(mocksconsole.NewContext(t)))
+	})
+
+	assert.Contains(t, captureOutput, "Goravel installer configuration:")
+	assert.Contains(t, captureOutput, "Project config: "+localPath)
+	assert.Contains(t, captureOutput, "new.type = \n")
+	assert.Contains(t, captureOutput, "skill.path = ~/skills\n")
+	assert.Contains(t, captureOutput, "   Source: "+localPath)
+}
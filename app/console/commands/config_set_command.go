@@ -0,0 +1,120 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+
+	"github.com/goravel/framework/contracts/console"
+	"github.com/goravel/framework/contracts/console/command"
+	"github.com/goravel/framework/support/color"
+)
+
+type ConfigSetCommand struct{}
+
+func NewConfigSetCommand() *ConfigSetCommand {
+	return &ConfigSetCommand{}
+}
+
+// Signature The name and signature of the console command.
+func (r *ConfigSetCommand) Signature() string {
+	return "config:set"
+}
+
+// Description The console command description.
+func (r *ConfigSetCommand) Description() string {
+	return "Set a Goravel installer configuration value"
+}
+
+// Extend The console command extend.
+func (r *ConfigSetCommand) Extend() command.Extend {
+	return command.Extend{
+		ArgsUsage: " <key> [value]",
+		Arguments: []command.Argument{
+			&command.ArgumentString{
+				Name:     "key",
+				Usage:    "The configuration key, e.g. new.type",
+				Required: true,
+			},
+			&command.ArgumentString{
+				Name:  "value",
+				Usage: "The configuration value. Removes the key when omitted",
+			},
+		},
+		Flags: []command.Flag{
+			&command.BoolFlag{
+				Name:               "local",
+				Aliases:            []string{"l"},
+				Usage:              "Write to the .goravelrc file of the current directory instead of the user configuration",
+				DisableDefaultText: true,
+			},
+		},
+	}
+}
+
+// Handle Execute the console command.
+func (r *ConfigSetCommand) Handle(ctx console.Context) error {
+	key, err := getInstallerConfigKey(ctx.ArgumentString("key"))
+	if err != nil {
+		color.Errorln(err)
+		return nil
+	}
+
+	if slices.Contains(userOnlyInstallerConfigKeys, key.Name) && ctx.OptionBool("local") {
+		color.Errorln(fmt.Sprintf("%s can only be set in the user configuration, remove the --local option", key.Name))
+		return nil
+	}
+
+	value := ctx.ArgumentString("value")
+	if value != "" && key.Validate != nil {
+		if err := key.Validate(value); err != nil {
+			color.Errorln(err)
+			return nil
+		}
+	}
+
+	path, err := r.getPath(ctx.OptionBool("local"))
+	if err != nil {
+		color.Errorln(err)
+		return nil
+	}
+
+	values, err := readInstallerConfigFile(path)
+	if err != nil {
+		color.Errorln(err)
+		return nil
+	}
+
+	if value == "" {
+		delete(values, key.Name)
+	} else {
+		values[key.Name] = value
+	}
+
+	if err := writeInstallerConfigFile(path, values); err != nil {
+		color.Errorln(fmt.Errorf("failed to write %s: %w", path, err))
+		return nil
+	}
+
+	if value == "" {
+		color.Successf("Removed %s from %s\n", key.Name, path)
+	} else {
+		color.Successf("Set %s to %q in %s\n", key.Name, value, path)
+	}
+
+	return nil
+}
+
+func (r *ConfigSetCommand) getPath(local bool) (string, error) {
+	if !local {
+		return userInstallerConfigPath()
+	}
+
+	pwd, err := os.Getwd()
+	if err != nil {
+		return "", fmt.Errorf("failed to get current directory: %w", err)
+	}
+
+	return filepath.Join(pwd, localInstallerConfigFile), nil
+}
@@ -0,0 +1,101 @@
+package commands
+
+import (
+	"io"
+	"path/filepath"
+	"testing"
+
+	mocksconsole "github.com/goravel/framework/mocks/console"
+	"github.com/goravel/framework/support/color"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfigSetCommand(t *testing.T) {
+	configSetCommand := NewConfigSetCommand()
+
+	t.Run("set a user value", func(t *testing.T) {
+		isolateUserDirs(t)
+
+		mockContext := mocksconsole.NewContext(t)
+		mockContext.EXPECT().ArgumentString("key").Return("new.type").Once()
+		mockContext.EXPECT().ArgumentString("value").Return("lite").Once()
+		mockContext.EXPECT().OptionBool("local").Return(false).Once()
+
+		captureOutput := color.CaptureOutput(func(w io.Writer) {
+			assert.Nil(t, configSetCommand.Handle(mockContext))
+		})
+
+		userPath, err := userInstallerConfigPath()
+		assert.Nil(t, err)
+		assert.Contains(t, captureOutput, `Set new.type to "lite"`)
+		values, err := readInstallerConfigFile(userPath)
+		assert.Nil(t, err)
+		assert.Equal(t, map[string]string{"new.type": "lite"}, values)
+	})
+
+	t.Run("set and remove a local value", func(t *testing.T) {
+		isolateUserDirs(t)
+		dir := t.TempDir()
+		t.Chdir(dir)
+		localPath := filepath.Join(dir, localInstallerConfigFile)
+		writeFile(t, localPath, "new:\n  dev: true\n")
+
+		mockContext := mocksconsole.NewContext(t)
+		mockContext.EXPECT().ArgumentString("key").Return("new.module_prefix").Once()
+		mockContext.EXPECT().ArgumentString("value").Return("github.com/acme").Once()
+		mockContext.EXPECT().OptionBool("local").Return(true).Once()
+		assert.Nil(t, configSetCommand.Handle(mockContext))
+
+		values, err := readInstallerConfigFile(localPath)
+		assert.Nil(t, err)
+		assert.Equal(t, map[string]string{"new.dev": "true", "new.module_prefix": "github.com/acme"}, values)
+
+		mockContext = mocksconsole.NewContext(t)
+		mockContext.EXPECT().ArgumentString("key").Return("new.dev").Once()
+		mockContext.EXPECT().ArgumentString("value").Return("").Once()
+		mockContext.EXPECT().OptionBool("local").Return(true).Once()
+		captureOutput := color.CaptureOutput(func(w io.Writer) {
+			assert.Nil(t, configSetCommand.Handle(mockContext))
+		})
+
+		assert.Contains(t, captureOutput, "Removed new.dev")
+		values, err = readInstallerConfigFile(localPath)
+		assert.Nil(t, err)
+		assert.Equal(t, map[string]string{"new.module_prefix": "github.com/acme"}, values)
+	})
+
+	t.Run("user only key in the local config", func(t *testing.T) {
+		mockContext := mocksconsole.NewContext(t)
+		mockContext.EXPECT().ArgumentString("key").Return("new.trusted_templates").Once()
+		mockContext.EXPECT().OptionBool("local").Return(true).Once()
+
+		captureOutput := color.CaptureOutput(func(w io.Writer) {
+			assert.Nil(t, configSetCommand.Handle(mockContext))
+		})
+
+		assert.Contains(t, captureOutput, "new.trusted_templates can only be set in the user configuration")
+	})
+
+	t.Run("unknown key", func(t *testing.T) {
+		mockContext := mocksconsole.NewContext(t)
+		mockContext.EXPECT().ArgumentString("key").Return("new.unknown").Once()
+
+		captureOutput := color.CaptureOutput(func(w io.Writer) {
+			assert.Nil(t, configSetCommand.Handle(mockContext))
+		})
+
+		assert.Contains(t, captureOutput, `unknown config key "new.unknown"`)
+	})
+
+	t.Run("invalid value", func(t *testing.T) {
+		mockContext := mocksconsole.NewContext(t)
+		mockContext.EXPECT().ArgumentString("key").Return("new.dev").Once()
+		mockContext.EXPECT().ArgumentString("value").Return("sometimes").Once()
+
+		captureOutput := color.CaptureOutput(func(w io.Writer) {
+			assert.Nil(t, configSetCommand.Handle(mockContext))
+		})
+
+		assert.Contains(t, captureOutput, `invalid boolean "sometimes"`)
+	})
+}
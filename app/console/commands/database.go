@@ -0,0 +1,105 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/goravel/framework/support/color"
+)
+
+const sqliteDatabase = "database/database.sqlite"
+
+// databaseDriver describes a database that can be selected when creating a project.
+type databaseDriver struct {
+	Name    string
+	Label   string
+	Package string
+	Env     [][2]string
+}
+
+var databaseDrivers = []databaseDriver{
+	{
+		Name:    "postgres",
+		Label:   "PostgreSQL",
+		Package: "github.com/goravel/postgres",
+		Env:     [][2]string{{"DB_CONNECTION", "postgres"}, {"DB_HOST", "127.0.0.1"}, {"DB_PORT", "5432"}},
+	},
+	{
+		Name:    "mysql",
+		Label:   "MySQL",
+		Package: "github.com/goravel/mysql",
+		Env:     [][2]string{{"DB_CONNECTION", "mysql"}, {"DB_HOST", "127.0.0.1"}, {"DB_PORT", "3306"}},
+	},
+	{
+		Name:    "sqlserver",
+		Label:   "SQL Server",
+		Package: "github.com/goravel/sqlserver",
+		Env:     [][2]string{{"DB_CONNECTION", "sqlserver"}, {"DB_HOST", "127.0.0.1"}, {"DB_PORT", "1433"}},
+	},
+	{
+		Name:    "sqlite",
+		Label:   "SQLite",
+		Package: "github.com/goravel/sqlite",
+		Env:     [][2]string{{"DB_CONNECTION", "sqlite"}, {"DB_HOST", ""}, {"DB_PORT", ""}, {"DB_DATABASE", sqliteDatabase}, {"DB_USERNAME", ""}, {"DB_PASSWORD", ""}},
+	},
+}
+
+// getDatabaseDriver Get the driver by name.
+func getDatabaseDriver(name string) (databaseDriver, error) {
+	index := slices.IndexFunc(databaseDrivers, func(driver databaseDriver) bool {
+		return driver.Name == name
+	})
+	if index == -1 {
+		return databaseDriver{}, fmt.Errorf("invalid database %q, use one of: %s", name, strings.Join(databaseDriverNames(), ", "))
+	}
+
+	return databaseDrivers[index], nil
+}
+
+func databaseDriverNames() []string {
+	names := make([]string, len(databaseDrivers))
+	for i, driver := range databaseDrivers {
+		names[i] = driver.Name
+	}
+
+	return names
+}
+
+// configureDatabase Point the .env file of the project to the driver, the SQLite database file is created as well.
+func configureDatabase(path string, driver databaseDriver) error {
+	if err := setEnvValues(filepath.Join(path, ".env"), driver.Env); err != nil {
+		return fmt.Errorf("failed to configure the database: %s", err)
+	}
+
+	if driver.Name == "sqlite" {
+		database := filepath.Join(path, filepath.FromSlash(sqliteDatabase))
+		if err := os.MkdirAll(filepath.Dir(database), 0755); err != nil {
+			return fmt.Errorf("failed to create the SQLite database: %s", err)
+		}
+		f, err := os.OpenFile(database, os.O_CREATE|os.O_WRONLY, 0644)
+		if err != nil {
+			return fmt.Errorf("failed to create the SQLite database: %s", err)
+		}
+		if err := f.Close(); err != nil {
+			return fmt.Errorf("failed to create the SQLite database: %s", err)
+		}
+	}
+
+	color.Successln("Configured the " + driver.Label + " database")
+
+	return nil
+}
+
+// installDatabaseDriver Install the driver package through artisan, lite projects don't ship any driver.
+func installDatabaseDriver(path string, driver databaseDriver, options goModuleOptions) error {
+	if res := options.Process(path, "Installing the "+driver.Label+" driver").Run("go", "run", ".", "artisan", "package:install", driver.Package); res.Failed() {
+		return fmt.Errorf("failed to install the %s driver: %s", driver.Label, res.Error())
+	}
+
+	color.Successln("Installed the " + driver.Label + " driver")
+
+	return nil
+}
@@ -0,0 +1,55 @@
+package commands
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	mocksprocess "github.com/goravel/framework/mocks/process"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfigureDatabase(t *testing.T) {
+	t.Run("mysql", func(t *testing.T) {
+		path := t.TempDir()
+		writeFile(t, filepath.Join(path, ".env"), "DB_CONNECTION=postgres\nDB_HOST=127.0.0.1\nDB_PORT=5432\nDB_DATABASE=goravel\n")
+
+		driver, err := getDatabaseDriver("mysql")
+		assert.Nil(t, err)
+		assert.Nil(t, configureDatabase(path, driver))
+
+		content, err := os.ReadFile(filepath.Join(path, ".env"))
+		assert.Nil(t, err)
+		assert.Equal(t, "DB_CONNECTION=mysql\nDB_HOST=127.0.0.1\nDB_PORT=3306\nDB_DATABASE=goravel\n", string(content))
+		assert.NoFileExists(t, filepath.Join(path, "database", "database.sqlite"))
+	})
+
+	t.Run("sqlite", func(t *testing.T) {
+		path := t.TempDir()
+		writeFile(t, filepath.Join(path, ".env"), "DB_CONNECTION=postgres\nDB_HOST=127.0.0.1\nDB_PORT=5432\nDB_DATABASE=goravel\nDB_USERNAME=root\nDB_PASSWORD=secret\n")
+
+		driver, err := getDatabaseDriver("sqlite")
+		assert.Nil(t, err)
+		assert.Nil(t, configureDatabase(path, driver))
+
+		content, err := os.ReadFile(filepath.Join(path, ".env"))
+		assert.Nil(t, err)
+		assert.Equal(t, "DB_CONNECTION=sqlite\nDB_HOST=\nDB_PORT=\nDB_DATABASE=database/database.sqlite\nDB_USERNAME=\nDB_PASSWORD=\n", string(content))
+		assert.FileExists(t, filepath.Join(path, "database", "database.sqlite"))
+	})
+}
+
+func TestInstallDatabaseDriver(t *testing.T) {
+	mockProcess := mockNewProcess()
+	mockProcess.EXPECT().WithSpinner("Installing the PostgreSQL driver").Return(mockProcess).Once()
+	mockProcess.EXPECT().Path("project").Return(mockProcess).Once()
+	mockProcess.EXPECT().Env(map[string]string{"GOPROXY": "off"}).Return(mockProcess).Once()
+	mockResult := mocksprocess.NewResult(t)
+	mockResult.EXPECT().Failed().Return(true).Once()
+	mockResult.EXPECT().Error().Return(assert.AnError).Once()
+	mockProcess.EXPECT().Run("go", "run", ".", "artisan", "package:install", "github.com/goravel/postgres").Return(mockResult).Once()
+
+	driver, err := getDatabaseDriver("postgres")
+	assert.Nil(t, err)
+	assert.ErrorContains(t, installDatabaseDriver("project", driver, goModuleOptions{Proxy: "off"}), "failed to install the PostgreSQL driver")
+}
@@ -0,0 +1,295 @@
+package commands
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/goravel/framework/support/color"
+	"github.com/goravel/framework/support/file"
+	"golang.org/x/mod/modfile"
+)
+
+// dockerDefaultPort is the port of the app when the .env file doesn't set a valid APP_PORT.
+const dockerDefaultPort = "3000"
+
+// dockerAssetDirs are the directories copied next to the binary in the production image when the project has them.
+var dockerAssetDirs = []string{"database", "lang", "public", "resources", "storage"}
+
+// dockerDatabaseService describes the compose service of a database driver.
+type dockerDatabaseService struct {
+	Image       string
+	Port        string
+	Volume      string
+	Environment [][2]string
+	// Credentials are the .env values used when the template leaves them empty.
+	Credentials [][2]string
+}
+
+var dockerDatabaseServices = map[string]dockerDatabaseService{
+	"postgres": {
+		Image:       "postgres:17-alpine",
+		Port:        "5432",
+		Volume:      "/var/lib/postgresql/data",
+		Environment: [][2]string{{"POSTGRES_DB", "${DB_DATABASE}"}, {"POSTGRES_USER", "${DB_USERNAME}"}, {"POSTGRES_PASSWORD", "${DB_PASSWORD}"}},
+		Credentials: [][2]string{{"DB_DATABASE", "goravel"}, {"DB_USERNAME", "goravel"}, {"DB_PASSWORD", "secret"}},
+	},
+	"mysql": {
+		Image:       "mysql:8.4",
+		Port:        "3306",
+		Volume:      "/var/lib/mysql",
+		Environment: [][2]string{{"MYSQL_DATABASE", "${DB_DATABASE}"}, {"MYSQL_USER", "${DB_USERNAME}"}, {"MYSQL_PASSWORD", "${DB_PASSWORD}"}, {"MYSQL_ROOT_PASSWORD", "${DB_PASSWORD}"}},
+		Credentials: [][2]string{{"DB_DATABASE", "goravel"}, {"DB_USERNAME", "goravel"}, {"DB_PASSWORD", "secret"}},
+	},
+	"sqlserver": {
+		Image:       "mcr.microsoft.com/mssql/server:2022-latest",
+		Port:        "1433",
+		Volume:      "/var/opt/mssql",
+		Environment: [][2]string{{"ACCEPT_EULA", "Y"}, {"MSSQL_SA_PASSWORD", "${DB_PASSWORD}"}},
+		// The image only creates the sa login, its password has to meet the SQL Server complexity rules.
+		Credentials: [][2]string{{"DB_DATABASE", "master"}, {"DB_USERNAME", "sa"}, {"DB_PASSWORD", "Goravel_Secret1"}},
+	},
+}
+
+// dockerCompose is the data of the docker-compose.yml template.
+type dockerCompose struct {
+	Port            string
+	Database        string
+	DatabaseService dockerDatabaseService
+	Redis           bool
+	RedisPassword   bool
+	SQLite          string
+}
+
+const dockerfileTemplate = `FROM golang:{{.GoVersion}}-alpine AS builder
+WORKDIR /build
+COPY go.mod go.sum ./
+RUN go mod download
+COPY . .
+RUN CGO_ENABLED=0 GOOS=linux go build -trimpath -ldflags="-s -w" -o main .
+
+FROM alpine:3.22
+RUN apk add --no-cache ca-certificates tzdata
+WORKDIR /www
+COPY --from=builder /build/main /www/main
+{{- range .AssetDirs}}
+COPY --from=builder /build/{{.}}/ /www/{{.}}/
+{{- end}}
+EXPOSE {{.Port}}
+ENTRYPOINT ["/www/main"]
+`
+
+const dockerignoreTemplate = `.git
+.env
+Dockerfile
+docker-compose.yml
+storage/logs
+`
+
+const dockerComposeTemplate = `services:
+  app:
+    build: .
+    ports:
+      - "${APP_PORT:-{{.Port}}}:{{.Port}}"
+    env_file: .env
+    environment:
+      APP_HOST: 0.0.0.0
+      APP_PORT: {{.Port}}
+{{- if .DatabaseService.Image}}
+      DB_HOST: {{.Database}}
+      DB_PORT: {{.DatabaseService.Port}}
+{{- end}}
+{{- if .Redis}}
+      REDIS_HOST: redis
+      REDIS_PORT: 6379
+{{- end}}
+      MAIL_HOST: mailpit
+      MAIL_PORT: 1025
+    volumes:
+      - ./.env:/www/.env
+{{- if .SQLite}}
+      - ./{{.SQLite}}:/www/{{.SQLite}}
+{{- end}}
+    depends_on:
+{{- if .DatabaseService.Image}}
+      - {{.Database}}
+{{- end}}
+{{- if .Redis}}
+      - redis
+{{- end}}
+      - mailpit
+{{- if .DatabaseService.Image}}
+
+  {{.Database}}:
+    image: {{.DatabaseService.Image}}
+    environment:
+{{- range .DatabaseService.Environment}}
+      {{index . 0}}: "{{index . 1}}"
+{{- end}}
+    ports:
+      - "${DB_PORT:-{{.DatabaseService.Port}}}:{{.DatabaseService.Port}}"
+    volumes:
+      - {{.Database}}:{{.DatabaseService.Volume}}
+{{- end}}
+{{- if .Redis}}
+
+  redis:
+    image: redis:7-alpine
+{{- if .RedisPassword}}
+    command: ["redis-server", "--requirepass", "${REDIS_PASSWORD}"]
+{{- end}}
+    ports:
+      - "${REDIS_PORT:-6379}:6379"
+    volumes:
+      - redis:/data
+{{- end}}
+
+  mailpit:
+    image: axllent/mailpit
+    ports:
+      - "${MAIL_PORT:-1025}:1025"
+      - "8025:8025"
+{{- if or .DatabaseService.Image .Redis}}
+
+volumes:
+{{- if .DatabaseService.Image}}
+  {{.Database}}:
+{{- end}}
+{{- if .Redis}}
+  redis:
+{{- end}}
+{{- end}}
+`
+
+// generateDocker Generate a production Dockerfile and a docker-compose.yml with the services of the database and
+// cache drivers configured in the .env file, the credentials the services need are filled in the .env file. The env
+// values are set in the .env file afterwards, they take precedence over it. The files the project already has, e.g.
+// the ones of the template, are kept.
+func generateDocker(path string, env [][2]string) error {
+	envPath := filepath.Join(path, ".env")
+	values, err := readEnvValues(envPath)
+	if err != nil {
+		return fmt.Errorf("failed to read .env: %s", err)
+	}
+	for _, value := range env {
+		values[value[0]] = value[1]
+	}
+
+	port := values["APP_PORT"]
+	if _, err := strconv.ParseUint(port, 10, 16); err != nil {
+		port = dockerDefaultPort
+	}
+
+	compose := dockerCompose{
+		Port:     port,
+		Database: values["DB_CONNECTION"],
+		Redis:    slices.Contains([]string{values["CACHE_STORE"], values["QUEUE_CONNECTION"]}, "redis"),
+	}
+	compose.RedisPassword = compose.Redis && values["REDIS_PASSWORD"] != ""
+
+	defaults := [][2]string{{"MAIL_HOST", "127.0.0.1"}, {"MAIL_PORT", "1025"}}
+	if service, ok := dockerDatabaseServices[compose.Database]; ok {
+		compose.DatabaseService = service
+		if compose.Database == "mysql" && values["DB_USERNAME"] == "root" {
+			// The MySQL image refuses to create a second root user.
+			service.Environment = slices.DeleteFunc(slices.Clone(service.Environment), func(value [2]string) bool {
+				return value[0] == "MYSQL_USER" || value[0] == "MYSQL_PASSWORD"
+			})
+			compose.DatabaseService = service
+		}
+		defaults = append(defaults, service.Credentials...)
+	} else if compose.Database == "sqlite" {
+		compose.SQLite = values["DB_DATABASE"]
+	}
+
+	// The credentials are only filled in for the services of a generated docker-compose.yml
+	var missing [][2]string
+	if !file.Exists(filepath.Join(path, "docker-compose.yml")) {
+		for _, value := range defaults {
+			if values[value[0]] == "" {
+				missing = append(missing, value)
+			}
+		}
+	}
+	if len(missing) > 0 {
+		if err := setEnvValues(envPath, missing); err != nil {
+			return fmt.Errorf("failed to update .env: %s", err)
+		}
+	}
+
+	var assetDirs []string
+	for _, dir := range dockerAssetDirs {
+		if info, err := os.Stat(filepath.Join(path, dir)); err == nil && info.IsDir() {
+			assetDirs = append(assetDirs, dir)
+		}
+	}
+
+	files := []struct {
+		name     string
+		template string
+		data     any
+	}{
+		{name: "Dockerfile", template: dockerfileTemplate, data: map[string]any{"GoVersion": getGoVersion(path), "AssetDirs": assetDirs, "Port": port}},
+		{name: ".dockerignore", template: dockerignoreTemplate},
+		{name: "docker-compose.yml", template: dockerComposeTemplate, data: compose},
+	}
+	var generated, skipped []string
+	for _, dockerFile := range files {
+		if file.Exists(filepath.Join(path, dockerFile.name)) {
+			skipped = append(skipped, dockerFile.name)
+			continue
+		}
+
+		content, err := renderDockerTemplate(dockerFile.name, dockerFile.template, dockerFile.data)
+		if err != nil {
+			return fmt.Errorf("failed to generate %s: %s", dockerFile.name, err)
+		}
+		if err := os.WriteFile(filepath.Join(path, dockerFile.name), content, 0644); err != nil {
+			return fmt.Errorf("failed to generate %s: %s", dockerFile.name, err)
+		}
+		generated = append(generated, dockerFile.name)
+	}
+
+	if len(generated) > 0 {
+		color.Successln("Generated " + strings.Join(generated, ", "))
+	}
+	if len(skipped) > 0 {
+		color.Warnln("Kept the existing " + strings.Join(skipped, ", ") + ", remove them to generate them again")
+	}
+
+	return nil
+}
+
+func renderDockerTemplate(name, text string, data any) ([]byte, error) {
+	tmpl, err := template.New(name).Parse(text)
+	if err != nil {
+		return nil, err
+	}
+
+	var buffer bytes.Buffer
+	if err := tmpl.Execute(&buffer, data); err != nil {
+		return nil, err
+	}
+
+	return buffer.Bytes(), nil
+}
+
+// getGoVersion Get the major and minor Go version required by the go.mod file of the project.
+func getGoVersion(path string) string {
+	content, err := os.ReadFile(filepath.Join(path, "go.mod"))
+	if err == nil {
+		if file, err := modfile.ParseLax("go.mod", content, nil); err == nil && file.Go != nil {
+			parts := strings.SplitN(file.Go.Version, ".", 3)
+			if len(parts) >= 2 {
+				return parts[0] + "." + parts[1]
+			}
+		}
+	}
+
+	return "1"
+}
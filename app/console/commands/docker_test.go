@@ -0,0 +1,151 @@
+package commands
+
+import (
+	"io"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/goravel/framework/support/color"
+	"github.com/stretchr/testify/assert"
+	"go.yaml.in/yaml/v3"
+)
+
+func TestGenerateDocker(t *testing.T) {
+	readCompose := func(t *testing.T, path string) map[string]any {
+		t.Helper()
+
+		content, err := os.ReadFile(filepath.Join(path, "docker-compose.yml"))
+		assert.Nil(t, err)
+
+		var compose map[string]any
+		assert.Nil(t, yaml.Unmarshal(content, &compose))
+
+		return compose
+	}
+
+	t.Run("postgres and redis", func(t *testing.T) {
+		path := t.TempDir()
+		writeFile(t, filepath.Join(path, "go.mod"), "module github.com/acme/blog\n\ngo 1.25.3\n")
+		writeFile(t, filepath.Join(path, "public", "favicon.ico"), "")
+		writeFile(t, filepath.Join(path, "storage", "app", ".gitignore"), "")
+		writeFile(t, filepath.Join(path, ".env"), "APP_NAME=Blog\nAPP_PORT=8080\nDB_CONNECTION=postgres\nDB_HOST=127.0.0.1\nDB_PORT=5432\nDB_DATABASE=blog\nDB_USERNAME=\nDB_PASSWORD=\nCACHE_STORE=redis\nMAIL_HOST=\n")
+
+		assert.Nil(t, generateDocker(path, nil))
+
+		dockerfile, err := os.ReadFile(filepath.Join(path, "Dockerfile"))
+		assert.Nil(t, err)
+		assert.Contains(t, string(dockerfile), "FROM golang:1.25-alpine AS builder\n")
+		assert.Contains(t, string(dockerfile), "COPY --from=builder /build/main /www/main\nCOPY --from=builder /build/public/ /www/public/\nCOPY --from=builder /build/storage/ /www/storage/\nEXPOSE 8080\n")
+		assert.FileExists(t, filepath.Join(path, ".dockerignore"))
+
+		env, err := readEnvValues(filepath.Join(path, ".env"))
+		assert.Nil(t, err)
+		assert.Equal(t, "blog", env["DB_DATABASE"])
+		assert.Equal(t, "goravel", env["DB_USERNAME"])
+		assert.Equal(t, "secret", env["DB_PASSWORD"])
+		assert.Equal(t, "127.0.0.1", env["MAIL_HOST"])
+		assert.Equal(t, "1025", env["MAIL_PORT"])
+
+		compose := readCompose(t, path)
+		services := compose["services"].(map[string]any)
+		assert.ElementsMatch(t, []string{"app", "postgres", "redis", "mailpit"}, slices.Collect(maps.Keys(services)))
+		app := services["app"].(map[string]any)
+		assert.Equal(t, []any{"${APP_PORT:-8080}:8080"}, app["ports"])
+		assert.Equal(t, 8080, app["environment"].(map[string]any)["APP_PORT"])
+		assert.Equal(t, "postgres", app["environment"].(map[string]any)["DB_HOST"])
+		assert.Equal(t, "redis", app["environment"].(map[string]any)["REDIS_HOST"])
+		assert.Equal(t, []any{"postgres", "redis", "mailpit"}, app["depends_on"])
+		assert.Equal(t, map[string]any{
+			"POSTGRES_DB":       "${DB_DATABASE}",
+			"POSTGRES_USER":     "${DB_USERNAME}",
+			"POSTGRES_PASSWORD": "${DB_PASSWORD}",
+		}, services["postgres"].(map[string]any)["environment"])
+		assert.NotContains(t, services["redis"], "command")
+		assert.ElementsMatch(t, []string{"postgres", "redis"}, slices.Collect(maps.Keys(compose["volumes"].(map[string]any))))
+	})
+
+	t.Run("mysql as root", func(t *testing.T) {
+		path := t.TempDir()
+		writeFile(t, filepath.Join(path, ".env"), "DB_CONNECTION=mysql\nDB_DATABASE=blog\nDB_USERNAME=root\nDB_PASSWORD=password\n")
+
+		assert.Nil(t, generateDocker(path, nil))
+
+		services := readCompose(t, path)["services"].(map[string]any)
+		assert.Equal(t, map[string]any{
+			"MYSQL_DATABASE":      "${DB_DATABASE}",
+			"MYSQL_ROOT_PASSWORD": "${DB_PASSWORD}",
+		}, services["mysql"].(map[string]any)["environment"])
+		assert.Len(t, dockerDatabaseServices["mysql"].Environment, 4)
+	})
+
+	t.Run("env values take precedence", func(t *testing.T) {
+		path := t.TempDir()
+		writeFile(t, filepath.Join(path, ".env"), "DB_CONNECTION=sqlite\nDB_DATABASE=database/database.sqlite\nDB_USERNAME=\n")
+
+		assert.Nil(t, generateDocker(path, [][2]string{{"DB_CONNECTION", "postgres"}, {"DB_USERNAME", "admin"}}))
+
+		services := readCompose(t, path)["services"].(map[string]any)
+		assert.Contains(t, services, "postgres")
+
+		env, err := readEnvValues(filepath.Join(path, ".env"))
+		assert.Nil(t, err)
+		assert.Equal(t, "", env["DB_USERNAME"])
+		assert.Equal(t, "secret", env["DB_PASSWORD"])
+	})
+
+	t.Run("keeps the existing files", func(t *testing.T) {
+		path := t.TempDir()
+		writeFile(t, filepath.Join(path, ".env"), "DB_CONNECTION=postgres\nDB_USERNAME=\n")
+		writeFile(t, filepath.Join(path, "Dockerfile"), "FROM scratch\n")
+		writeFile(t, filepath.Join(path, "docker-compose.yml"), "services: {}\n")
+
+		var err error
+		captureOutput := color.CaptureOutput(func(w io.Writer) {
+			err = generateDocker(path, nil)
+		})
+		assert.Nil(t, err)
+		assert.Contains(t, captureOutput, "Generated .dockerignore")
+		assert.Contains(t, captureOutput, "Kept the existing Dockerfile, docker-compose.yml")
+
+		dockerfile, err := os.ReadFile(filepath.Join(path, "Dockerfile"))
+		assert.Nil(t, err)
+		assert.Equal(t, "FROM scratch\n", string(dockerfile))
+		assert.Equal(t, map[string]any{"services": map[string]any{}}, readCompose(t, path))
+
+		env, err := readEnvValues(filepath.Join(path, ".env"))
+		assert.Nil(t, err)
+		assert.Equal(t, "", env["DB_USERNAME"])
+	})
+
+	t.Run("defaults to the port 3000", func(t *testing.T) {
+		path := t.TempDir()
+		writeFile(t, filepath.Join(path, ".env"), "APP_PORT=invalid\nDB_CONNECTION=sqlite\n")
+
+		assert.Nil(t, generateDocker(path, nil))
+
+		dockerfile, err := os.ReadFile(filepath.Join(path, "Dockerfile"))
+		assert.Nil(t, err)
+		assert.Contains(t, string(dockerfile), "EXPOSE 3000\n")
+		assert.Equal(t, []any{"${APP_PORT:-3000}:3000"}, readCompose(t, path)["services"].(map[string]any)["app"].(map[string]any)["ports"])
+	})
+
+	t.Run("sqlite", func(t *testing.T) {
+		path := t.TempDir()
+		writeFile(t, filepath.Join(path, ".env"), "DB_CONNECTION=sqlite\nDB_DATABASE=database/database.sqlite\nDB_USERNAME=\n")
+
+		assert.Nil(t, generateDocker(path, nil))
+
+		compose := readCompose(t, path)
+		services := compose["services"].(map[string]any)
+		assert.ElementsMatch(t, []string{"app", "mailpit"}, slices.Collect(maps.Keys(services)))
+		assert.Equal(t, []any{"./.env:/www/.env", "./database/database.sqlite:/www/database/database.sqlite"}, services["app"].(map[string]any)["volumes"])
+		assert.NotContains(t, compose, "volumes")
+
+		env, err := readEnvValues(filepath.Join(path, ".env"))
+		assert.Nil(t, err)
+		assert.Equal(t, "", env["DB_USERNAME"])
+	})
+}
@@ -0,0 +1,371 @@
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+	"go/version"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/goravel/framework/contracts/console"
+	"github.com/goravel/framework/contracts/console/command"
+	"github.com/goravel/framework/errors"
+	"github.com/goravel/framework/support/color"
+	"golang.org/x/mod/modfile"
+
+	"github.com/goravel/installer/app/facades"
+)
+
+const (
+	doctorPass = "pass"
+	doctorWarn = "warn"
+	doctorFail = "fail"
+)
+
+// skeletonGoModURL is the go.mod of the goravel skeleton, it's read when the template is not cached.
+const skeletonGoModURL = "https://raw.githubusercontent.com/goravel/goravel/HEAD/go.mod"
+
+// doctorHTTPClient is used to reach GOPROXY and the skeleton, it can be replaced in tests.
+var doctorHTTPClient = &http.Client{Timeout: 10 * time.Second}
+
+// doctorCheck is the result of a check, the fix tells how to solve a warning or a failure.
+type doctorCheck struct {
+	Name    string
+	Status  string
+	Message string
+	Fix     string
+}
+
+// goEnv is the part of `go env -json` the checks use.
+type goEnv struct {
+	GOBIN     string
+	GOPATH    string
+	GOPROXY   string
+	GOVERSION string
+}
+
+type DoctorCommand struct {
+}
+
+func NewDoctorCommand() *DoctorCommand {
+	return &DoctorCommand{}
+}
+
+// Signature The name and signature of the console command.
+func (r *DoctorCommand) Signature() string {
+	return "doctor"
+}
+
+// Description The console command description.
+func (r *DoctorCommand) Description() string {
+	return "Diagnose the local environment the installer depends on"
+}
+
+// Extend The console command extend.
+func (r *DoctorCommand) Extend() command.Extend {
+	return command.Extend{}
+}
+
+// Handle Execute the console command.
+func (r *DoctorCommand) Handle(ctx console.Context) error {
+	var failed int
+	for _, check := range r.runChecks() {
+		r.printCheck(check)
+		if check.Status == doctorFail {
+			failed++
+		}
+	}
+
+	ctx.NewLine()
+	if failed > 0 {
+		return fmt.Errorf("%d check(s) failed, fix them and run the doctor command again", failed)
+	}
+
+	color.Successln("Your environment is ready to create Goravel projects")
+
+	return nil
+}
+
+func (r *DoctorCommand) runChecks() []doctorCheck {
+	env, err := getGoEnv()
+	if err != nil {
+		skipped := "Skipped, it needs Go"
+		return []doctorCheck{
+			{Name: "Go", Status: doctorFail, Message: err.Error(), Fix: "Install Go from https://go.dev/dl and make sure it's in PATH"},
+			r.checkGit(),
+			{Name: "GOPROXY", Status: doctorWarn, Message: skipped},
+			{Name: "GOBIN", Status: doctorWarn, Message: skipped},
+			r.checkSkillsPath(),
+		}
+	}
+
+	return []doctorCheck{
+		r.checkGoVersion(env.GOVERSION),
+		r.checkGit(),
+		r.checkGoProxy(env.GOPROXY),
+		r.checkGoBin(env),
+		r.checkSkillsPath(),
+	}
+}
+
+func (r *DoctorCommand) printCheck(check doctorCheck) {
+	switch check.Status {
+	case doctorPass:
+		color.Printfln("<fg=green>[PASS]</> %s: %s", check.Name, check.Message)
+	case doctorWarn:
+		color.Printfln("<fg=yellow>[WARN]</> %s: %s", check.Name, check.Message)
+	default:
+		color.Printfln("<fg=red>[FAIL]</> %s: %s", check.Name, check.Message)
+	}
+
+	if check.Fix != "" && check.Status != doctorPass {
+		color.Gray().Println("       Fix: " + check.Fix)
+	}
+}
+
+// checkGoVersion Compare the local Go version with the go directive of the skeleton, go mod tidy fails on an older one.
+func (r *DoctorCommand) checkGoVersion(local string) doctorCheck {
+	check := doctorCheck{Name: "Go"}
+
+	required, err := getSkeletonGoVersion()
+	if err != nil {
+		check.Status = doctorWarn
+		check.Message = fmt.Sprintf("%s is installed, unable to read the Go version of the skeleton: %s", local, err)
+
+		return check
+	}
+
+	if !version.IsValid(local) {
+		check.Status = doctorWarn
+		check.Message = fmt.Sprintf("%s is installed, unable to compare it with go%s required by the skeleton", local, required)
+
+		return check
+	}
+	if version.Compare(local, "go"+required) < 0 {
+		check.Status = doctorFail
+		check.Message = fmt.Sprintf("%s is installed, the skeleton requires go%s or newer", local, required)
+		check.Fix = "Upgrade Go from https://go.dev/dl"
+
+		return check
+	}
+
+	check.Status = doctorPass
+	check.Message = fmt.Sprintf("%s is installed, the skeleton requires go%s", local, required)
+
+	return check
+}
+
+// checkGit Verify git, the installer downloads archives without it, but it can't initialize a repository.
+func (r *DoctorCommand) checkGit() doctorCheck {
+	check := doctorCheck{Name: "git"}
+	if !gitInstalled() {
+		check.Status = doctorWarn
+		check.Message = "git is not installed, the templates are downloaded as archives and --git is not available"
+		check.Fix = "Install git from https://git-scm.com/downloads"
+
+		return check
+	}
+
+	if err := checkGitIdentity(); err != nil {
+		check.Status = doctorWarn
+		check.Message = "git is installed, but the identity is not configured, --git can't create the initial commit"
+		check.Fix = `git config --global user.name "Your Name" && git config --global user.email "you@example.com"`
+
+		return check
+	}
+
+	check.Status = doctorPass
+	check.Message = "git is installed and configured"
+
+	return check
+}
+
+// checkGoProxy Verify that the first proxy of GOPROXY answers, go mod tidy downloads the dependencies through it.
+func (r *DoctorCommand) checkGoProxy(goproxy string) doctorCheck {
+	check := doctorCheck{Name: "GOPROXY", Fix: "go env -w GOPROXY=https://proxy.golang.org,direct"}
+
+	var proxy string
+	for _, entry := range strings.FieldsFunc(goproxy, func(r rune) bool {
+		return r == ',' || r == '|'
+	}) {
+		if entry == "off" {
+			check.Status = doctorFail
+			check.Message = "GOPROXY=" + goproxy + " disables downloading modules"
+
+			return check
+		}
+		if entry != "direct" {
+			proxy = entry
+			break
+		}
+	}
+	if proxy == "" {
+		check.Status = doctorPass
+		check.Message = "GOPROXY=" + goproxy + ", the modules are downloaded from their repositories"
+
+		return check
+	}
+
+	url := strings.TrimRight(proxy, "/") + "/github.com/goravel/framework/@latest"
+	response, err := doctorHTTPClient.Get(url)
+	if err != nil {
+		check.Status = doctorFail
+		check.Message = fmt.Sprintf("unable to reach %s: %s", proxy, err)
+
+		return check
+	}
+	defer errors.Ignore(response.Body.Close)
+
+	if response.StatusCode != http.StatusOK {
+		check.Status = doctorWarn
+		check.Message = fmt.Sprintf("%s is reachable, but it answers %s for github.com/goravel/framework", proxy, response.Status)
+
+		return check
+	}
+
+	check.Status = doctorPass
+	check.Message = proxy + " is reachable"
+
+	return check
+}
+
+// checkGoBin Verify that the directory go install writes to is in PATH, the upgrade command installs the installer
+// there.
+func (r *DoctorCommand) checkGoBin(env goEnv) doctorCheck {
+	check := doctorCheck{Name: "GOBIN"}
+
+	bin := env.GOBIN
+	if bin == "" {
+		paths := filepath.SplitList(env.GOPATH)
+		if len(paths) == 0 || paths[0] == "" {
+			check.Status = doctorWarn
+			check.Message = "neither GOBIN nor GOPATH is set"
+			check.Fix = "go env -w GOBIN=$HOME/go/bin and add it to PATH"
+
+			return check
+		}
+		bin = filepath.Join(paths[0], "bin")
+	}
+
+	if !slices.ContainsFunc(filepath.SplitList(os.Getenv("PATH")), func(path string) bool {
+		return path != "" && filepath.Clean(path) == filepath.Clean(bin)
+	}) {
+		check.Status = doctorWarn
+		check.Message = bin + " is not in PATH, the upgraded installer won't be found"
+		check.Fix = `Add it to PATH, e.g. export PATH="$PATH:` + bin + `" in your shell profile`
+
+		return check
+	}
+
+	check.Status = doctorPass
+	check.Message = bin + " is in PATH"
+
+	return check
+}
+
+// checkSkillsPath Verify that the skills can be installed, the closest existing directory of the path has to be
+// writable.
+func (r *DoctorCommand) checkSkillsPath() doctorCheck {
+	check := doctorCheck{Name: "Skills"}
+
+	path, err := getSkillsPath()
+	if err != nil {
+		check.Status = doctorFail
+		check.Message = err.Error()
+
+		return check
+	}
+
+	dir := path
+	for !verifyIfDirectoryExists(dir) && filepath.Dir(dir) != dir {
+		dir = filepath.Dir(dir)
+	}
+
+	tmp, err := os.CreateTemp(dir, ".goravel-doctor-*")
+	if err != nil {
+		check.Status = doctorFail
+		check.Message = dir + " is not writable, skills can't be installed to " + path
+		check.Fix = "Fix the permissions of " + dir + ", or set another folder with: goravel config:set skill.path <path>"
+
+		return check
+	}
+	errors.Ignore(tmp.Close)
+	_ = os.Remove(tmp.Name())
+
+	check.Status = doctorPass
+	check.Message = path + " is writable"
+
+	return check
+}
+
+// getGoEnv Read the Go environment, it fails when Go is not installed.
+func getGoEnv() (goEnv, error) {
+	var env goEnv
+
+	res := facades.Process().Quietly().Run("go", "env", "-json", "GOVERSION", "GOPROXY", "GOBIN", "GOPATH")
+	if res.Failed() {
+		return env, fmt.Errorf("go is not available: %s", res.Error())
+	}
+	if err := json.Unmarshal([]byte(res.Output()), &env); err != nil {
+		return env, fmt.Errorf("failed to parse go env: %s", err)
+	}
+
+	return env, nil
+}
+
+// getSkeletonGoVersion Get the go directive of the skeleton, from the template cache when it's there.
+func getSkeletonGoVersion() (string, error) {
+	var content []byte
+	if path, err := templateCachePath(goravelRepo, ""); err == nil {
+		content, _ = os.ReadFile(filepath.Join(path, "template", "go.mod"))
+	}
+
+	if content == nil {
+		response, err := doctorHTTPClient.Get(skeletonGoModURL)
+		if err != nil {
+			return "", err
+		}
+		defer errors.Ignore(response.Body.Close)
+
+		if response.StatusCode != http.StatusOK {
+			return "", fmt.Errorf("unexpected status %s from %s", response.Status, skeletonGoModURL)
+		}
+		if content, err = io.ReadAll(response.Body); err != nil {
+			return "", err
+		}
+	}
+
+	file, err := modfile.ParseLax("go.mod", content, nil)
+	if err != nil {
+		return "", err
+	}
+	if file.Go == nil {
+		return "", fmt.Errorf("the go.mod of the skeleton has no go directive")
+	}
+
+	return file.Go.Version, nil
+}
+
+// getSkillsPath Get the default destination of skill:install.
+func getSkillsPath() (string, error) {
+	config, err := loadInstallerConfig()
+	if err != nil {
+		return "", err
+	}
+
+	if path := config.Get("skill.path"); path != "" {
+		return expandHomePath(path)
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to get home directory: %w", err)
+	}
+
+	return filepath.Join(home, ".agents", "skills"), nil
+}
@@ -0,0 +1,191 @@
+package commands
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	mocksconsole "github.com/goravel/framework/mocks/console"
+	mocksprocess "github.com/goravel/framework/mocks/process"
+	"github.com/goravel/framework/support/color"
+	frameworkmock "github.com/goravel/framework/testing/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDoctorHandle(t *testing.T) {
+	t.Run("passes", func(t *testing.T) {
+		home := isolateUserDirs(t)
+		bin := filepath.Join(home, "go", "bin")
+		t.Setenv("PATH", bin)
+		setGitInstalled(t, false)
+		setDoctorHTTPClient(t, map[string]string{
+			skeletonGoModURL: "module goravel\n\ngo 1.24.0\n",
+			"https://proxy.golang.org/github.com/goravel/framework/@latest": `{"Version":"v1.18.0"}`,
+		})
+		expectGoEnv(t, `{"GOBIN":"","GOPATH":"`+filepath.ToSlash(filepath.Join(home, "go"))+`","GOPROXY":"https://proxy.golang.org,direct","GOVERSION":"go1.25.1"}`)
+
+		mockContext := mocksconsole.NewContext(t)
+		mockContext.EXPECT().NewLine().Once()
+
+		var err error
+		output := color.CaptureOutput(func(w io.Writer) {
+			err = NewDoctorCommand().Handle(mockContext)
+		})
+		assert.Nil(t, err)
+		assert.Contains(t, output, "Go: go1.25.1 is installed, the skeleton requires go1.24.0")
+		assert.Contains(t, output, "git: git is not installed")
+		assert.Contains(t, output, "Fix: Install git from https://git-scm.com/downloads")
+		assert.Contains(t, output, "GOPROXY: https://proxy.golang.org is reachable")
+		assert.Contains(t, output, "Skills: "+filepath.Join(home, ".agents", "skills")+" is writable")
+		assert.NotContains(t, output, "[FAIL]")
+		assert.Contains(t, output, "Your environment is ready to create Goravel projects")
+	})
+
+	t.Run("fails without go", func(t *testing.T) {
+		isolateUserDirs(t)
+		setGitInstalled(t, false)
+
+		mockProcess := frameworkmock.Factory().Process()
+		mockProcess.EXPECT().Quietly().Return(mockProcess).Once()
+		mockResult := mocksprocess.NewResult(t)
+		mockResult.EXPECT().Failed().Return(true).Once()
+		mockResult.EXPECT().Error().Return(errors.New("executable file not found in $PATH")).Once()
+		mockProcess.EXPECT().Run("go", "env", "-json", "GOVERSION", "GOPROXY", "GOBIN", "GOPATH").Return(mockResult).Once()
+
+		mockContext := mocksconsole.NewContext(t)
+		mockContext.EXPECT().NewLine().Once()
+
+		var err error
+		output := color.CaptureOutput(func(w io.Writer) {
+			err = NewDoctorCommand().Handle(mockContext)
+		})
+		assert.EqualError(t, err, "1 check(s) failed, fix them and run the doctor command again")
+		assert.Contains(t, output, "[FAIL]")
+		assert.Contains(t, output, "Go: go is not available: executable file not found in $PATH")
+		assert.Contains(t, output, "GOPROXY: Skipped, it needs Go")
+	})
+}
+
+func TestDoctorCheckGoVersion(t *testing.T) {
+	doctor := NewDoctorCommand()
+
+	t.Run("from the template cache", func(t *testing.T) {
+		isolateUserDirs(t)
+		path, err := templateCachePath(goravelRepo, "")
+		assert.Nil(t, err)
+		writeFile(t, filepath.Join(path, "template", "go.mod"), "module goravel\n\ngo 1.25.0\n")
+		setDoctorHTTPClient(t, nil)
+
+		check := doctor.checkGoVersion("go1.24.6")
+		assert.Equal(t, doctorFail, check.Status)
+		assert.Equal(t, "go1.24.6 is installed, the skeleton requires go1.25.0 or newer", check.Message)
+		assert.Equal(t, "Upgrade Go from https://go.dev/dl", check.Fix)
+	})
+
+	t.Run("skeleton unavailable", func(t *testing.T) {
+		isolateUserDirs(t)
+		setDoctorHTTPClient(t, nil)
+
+		check := doctor.checkGoVersion("go1.25.1")
+		assert.Equal(t, doctorWarn, check.Status)
+		assert.Contains(t, check.Message, "unable to read the Go version of the skeleton: unexpected status 404 Not Found")
+	})
+
+	t.Run("development version", func(t *testing.T) {
+		isolateUserDirs(t)
+		setDoctorHTTPClient(t, map[string]string{skeletonGoModURL: "module goravel\n\ngo 1.24.0\n"})
+
+		check := doctor.checkGoVersion("devel go1.26-abcdef")
+		assert.Equal(t, doctorWarn, check.Status)
+	})
+}
+
+func TestDoctorCheckGoProxy(t *testing.T) {
+	doctor := NewDoctorCommand()
+	setDoctorHTTPClient(t, map[string]string{
+		"https://goproxy.example.com/github.com/goravel/framework/@latest": `{"Version":"v1.18.0"}`,
+	})
+
+	tests := []struct {
+		goproxy string
+		status  string
+		message string
+	}{
+		{goproxy: "https://goproxy.example.com,direct", status: doctorPass, message: "https://goproxy.example.com is reachable"},
+		{goproxy: "direct", status: doctorPass, message: "GOPROXY=direct, the modules are downloaded from their repositories"},
+		{goproxy: "off", status: doctorFail, message: "GOPROXY=off disables downloading modules"},
+		{goproxy: "https://athens.example.com|direct", status: doctorWarn, message: "https://athens.example.com is reachable, but it answers 404 Not Found for github.com/goravel/framework"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.goproxy, func(t *testing.T) {
+			check := doctor.checkGoProxy(test.goproxy)
+			assert.Equal(t, test.status, check.Status)
+			assert.Equal(t, test.message, check.Message)
+		})
+	}
+}
+
+func TestDoctorCheckGoBin(t *testing.T) {
+	doctor := NewDoctorCommand()
+	bin := filepath.Join(t.TempDir(), "bin")
+	t.Setenv("PATH", strings.Join([]string{filepath.Join(t.TempDir(), "other"), bin}, string(os.PathListSeparator)))
+
+	assert.Equal(t, doctorPass, doctor.checkGoBin(goEnv{GOBIN: bin}).Status)
+	assert.Equal(t, doctorPass, doctor.checkGoBin(goEnv{GOPATH: filepath.Dir(bin)}).Status)
+
+	check := doctor.checkGoBin(goEnv{GOBIN: filepath.Join(t.TempDir(), "bin")})
+	assert.Equal(t, doctorWarn, check.Status)
+	assert.Contains(t, check.Message, "is not in PATH")
+
+	assert.Equal(t, doctorWarn, doctor.checkGoBin(goEnv{}).Status)
+}
+
+func TestDoctorCheckSkillsPath(t *testing.T) {
+	if runtime.GOOS == "windows" || os.Geteuid() == 0 {
+		t.Skip("the permissions of the directory are not enforced")
+	}
+
+	home := isolateUserDirs(t)
+	skills := filepath.Join(home, "readonly", "skills")
+	assert.Nil(t, os.MkdirAll(filepath.Dir(skills), 0555))
+	writeFile(t, filepath.Join(home, ".config", "goravel", "installer.yaml"), "skill:\n  path: "+skills+"\n")
+
+	check := NewDoctorCommand().checkSkillsPath()
+	assert.Equal(t, doctorFail, check.Status)
+	assert.Equal(t, filepath.Dir(skills)+" is not writable, skills can't be installed to "+skills, check.Message)
+}
+
+func expectGoEnv(t *testing.T, output string) {
+	t.Helper()
+
+	mockProcess := frameworkmock.Factory().Process()
+	mockProcess.EXPECT().Quietly().Return(mockProcess).Once()
+	mockResult := mocksprocess.NewResult(t)
+	mockResult.EXPECT().Failed().Return(false).Once()
+	mockResult.EXPECT().Output().Return(output).Once()
+	mockProcess.EXPECT().Run("go", "env", "-json", "GOVERSION", "GOPROXY", "GOBIN", "GOPATH").Return(mockResult).Once()
+}
+
+// setDoctorHTTPClient Answer the requests of the doctor command with the bodies of the URLs, other URLs get a 404.
+func setDoctorHTTPClient(t *testing.T, responses map[string]string) {
+	t.Helper()
+
+	original := doctorHTTPClient
+	doctorHTTPClient = &http.Client{Transport: roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		body, ok := responses[r.URL.String()]
+		if !ok {
+			return &http.Response{StatusCode: http.StatusNotFound, Status: "404 Not Found", Body: io.NopCloser(strings.NewReader(""))}, nil
+		}
+
+		return &http.Response{StatusCode: http.StatusOK, Status: "200 OK", Body: io.NopCloser(strings.NewReader(body))}, nil
+	})}
+	t.Cleanup(func() {
+		doctorHTTPClient = original
+	})
+}
@@ -0,0 +1,81 @@
+package commands
+
+import (
+	"os"
+	"slices"
+	"strings"
+)
+
+// readEnvValues Read the keys of an env file, comments and blank lines are ignored.
+func readEnvValues(path string) (map[string]string, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	values := make(map[string]string)
+	for _, line := range strings.Split(string(content), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			continue
+		}
+		values[strings.TrimSpace(key)] = strings.Trim(strings.TrimSpace(value), `"'`)
+	}
+
+	return values, nil
+}
+
+// setEnvValues Set the keys of an env file, existing keys are updated in place and keep their inline comment, missing
+// keys are appended.
+func setEnvValues(path string, values [][2]string) error {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	lines := strings.Split(strings.TrimRight(string(content), "\n"), "\n")
+	for _, value := range values {
+		line := value[0] + "=" + value[1]
+		index := slices.IndexFunc(lines, func(existing string) bool {
+			return strings.HasPrefix(strings.TrimSpace(existing), value[0]+"=")
+		})
+		if index == -1 {
+			lines = append(lines, line)
+			continue
+		}
+
+		lines[index] = line + envInlineComment(lines[index])
+	}
+
+	return os.WriteFile(path, []byte(strings.Join(lines, "\n")+"\n"), 0644)
+}
+
+// envInlineComment Get the inline comment of an env line with its leading spaces, e.g. " # seconds" of
+// "TIMEOUT=30 # seconds". A # in a quoted value is not a comment.
+func envInlineComment(line string) string {
+	_, value, _ := strings.Cut(line, "=")
+	value = strings.TrimLeft(value, " \t")
+	if value != "" && (value[0] == '"' || value[0] == '\'') {
+		end := strings.IndexByte(value[1:], value[0])
+		if end == -1 {
+			return ""
+		}
+		value = value[end+2:]
+		if strings.HasPrefix(strings.TrimLeft(value, " \t"), "#") {
+			return value
+		}
+
+		return ""
+	}
+
+	if index := strings.Index(value, " #"); index != -1 {
+		return value[index:]
+	}
+
+	return ""
+}
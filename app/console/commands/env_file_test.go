@@ -0,0 +1,36 @@
+package commands
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadEnvValues(t *testing.T) {
+	path := filepath.Join(t.TempDir(), ".env")
+	writeFile(t, path, "# Application\nAPP_NAME=Goravel\n\nDB_PASSWORD=\"secret\"\nDB_USERNAME=\ninvalid\n")
+
+	values, err := readEnvValues(path)
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]string{"APP_NAME": "Goravel", "DB_PASSWORD": "secret", "DB_USERNAME": ""}, values)
+}
+
+func TestSetEnvValues(t *testing.T) {
+	path := filepath.Join(t.TempDir(), ".env")
+	writeFile(t, path, "APP_NAME=Goravel\nDB_CONNECTION=postgres\nDB_PORT=5432 # the default port\n")
+
+	assert.Nil(t, setEnvValues(path, [][2]string{{"DB_CONNECTION", "mysql"}, {"DB_PORT", "3306"}, {"DB_CHARSET", "utf8mb4"}}))
+
+	content, err := os.ReadFile(path)
+	assert.Nil(t, err)
+	assert.Equal(t, "APP_NAME=Goravel\nDB_CONNECTION=mysql\nDB_PORT=3306 # the default port\nDB_CHARSET=utf8mb4\n", string(content))
+}
+
+func TestEnvInlineComment(t *testing.T) {
+	assert.Equal(t, "", envInlineComment("APP_PORT=3000"))
+	assert.Equal(t, " # seconds", envInlineComment("TIMEOUT=30 # seconds"))
+	assert.Equal(t, " # the name", envInlineComment(`APP_NAME="My # Blog" # the name`))
+	assert.Equal(t, "", envInlineComment(`APP_NAME="My # Blog"`))
+}
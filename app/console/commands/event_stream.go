@@ -0,0 +1,138 @@
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/goravel/framework/support/color"
+)
+
+const (
+	outputFormatText = "text"
+	outputFormatJSON = "json"
+)
+
+var outputFormats = []string{outputFormatText, outputFormatJSON}
+
+// eventOutput is where the JSON events are written, it can be replaced in tests.
+var eventOutput io.Writer = os.Stdout
+
+// eventStream writes the progress of a command as newline-delimited JSON events, one of step_started, step_finished,
+// warning, error or summary. A nil stream is the text output: the steps only run and the errors are printed.
+type eventStream struct {
+	writer  io.Writer
+	start   time.Time
+	summary map[string]any
+}
+
+// newEventStream Create the event stream of the format, nil is returned for the text format.
+func newEventStream(format string) (*eventStream, error) {
+	switch format {
+	case "", outputFormatText:
+		return nil, nil
+	case outputFormatJSON:
+		return &eventStream{writer: eventOutput, start: time.Now(), summary: make(map[string]any)}, nil
+	}
+
+	return nil, fmt.Errorf("invalid format %q, use one of: %s", format, strings.Join(outputFormats, ", "))
+}
+
+// Silence Run the action without writing any text to stdout, stdout only carries the events. The text of the
+// action, including the output of the processes it runs, is written to stderr instead.
+func (r *eventStream) Silence(action func() error) error {
+	if r == nil {
+		return action()
+	}
+
+	stdout := os.Stdout
+	os.Stdout = os.Stderr
+	defer func() {
+		os.Stdout = stdout
+	}()
+
+	var err error
+	text := color.CaptureOutput(func(io.Writer) {
+		err = action()
+	})
+	_, _ = io.WriteString(os.Stderr, text)
+
+	return err
+}
+
+// Step Run the action between a step_started and a step_finished event, the step_finished event has the duration
+// of the step and its error when it fails.
+func (r *eventStream) Step(step, message string, action func() error) error {
+	if r == nil {
+		return action()
+	}
+
+	r.emit("step_started", map[string]any{"step": step, "message": message})
+	start := time.Now()
+	err := action()
+
+	fields := map[string]any{"step": step, "duration_ms": time.Since(start).Milliseconds(), "status": "succeeded"}
+	if err != nil {
+		fields["status"] = "failed"
+		fields["error"] = err.Error()
+	}
+	r.emit("step_finished", fields)
+
+	return err
+}
+
+// Set Set a field of the summary event.
+func (r *eventStream) Set(key string, value any) {
+	if r != nil {
+		r.summary[key] = value
+	}
+}
+
+// Error Report the error that stops the command, it's returned by the command in the JSON format so the process
+// exits with a non-zero status.
+func (r *eventStream) Error(err error) error {
+	if r == nil {
+		color.Errorln(err)
+		return nil
+	}
+
+	r.emit("error", map[string]any{"message": err.Error(), "duration_ms": time.Since(r.start).Milliseconds()})
+
+	return err
+}
+
+// Warning Report a problem that doesn't stop the command.
+func (r *eventStream) Warning(message string) {
+	if r == nil {
+		color.Warnln(message)
+		return
+	}
+
+	r.emit("warning", map[string]any{"message": message})
+}
+
+// Summary Emit the summary event with the fields that have been set, it's the last event of a successful command.
+func (r *eventStream) Summary() {
+	if r == nil {
+		return
+	}
+
+	fields := maps.Clone(r.summary)
+	fields["duration_ms"] = time.Since(r.start).Milliseconds()
+	r.emit("summary", fields)
+}
+
+func (r *eventStream) emit(name string, fields map[string]any) {
+	event := map[string]any{"event": name, "time": time.Now().UTC().Format(time.RFC3339Nano)}
+	maps.Copy(event, fields)
+
+	content, err := json.Marshal(event)
+	if err != nil {
+		content, _ = json.Marshal(map[string]any{"event": "error", "message": err.Error()})
+	}
+	_, _ = r.writer.Write(append(content, '\n'))
+}
@@ -0,0 +1,138 @@
+package commands
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/goravel/framework/support/color"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewEventStream(t *testing.T) {
+	events, err := newEventStream("")
+	assert.Nil(t, err)
+	assert.Nil(t, events)
+
+	events, err = newEventStream("text")
+	assert.Nil(t, err)
+	assert.Nil(t, events)
+
+	events, err = newEventStream("json")
+	assert.Nil(t, err)
+	assert.NotNil(t, events)
+
+	_, err = newEventStream("yaml")
+	assert.EqualError(t, err, `invalid format "yaml", use one of: text, json`)
+}
+
+func TestEventStream(t *testing.T) {
+	t.Run("text", func(t *testing.T) {
+		var events *eventStream
+
+		output := color.CaptureOutput(func(io.Writer) {
+			assert.Nil(t, events.Step("fetch_template", "Fetch the template", func() error {
+				return nil
+			}))
+			assert.EqualError(t, events.Step("init_project", "Init the project", func() error {
+				return errors.New("failed to install dependencies")
+			}), "failed to install dependencies")
+			events.Set("path", "/srv/blog")
+			events.Warning("Skipped the hooks of the template")
+			assert.Nil(t, events.Error(errors.New("failed to install dependencies")))
+			events.Summary()
+		})
+
+		assert.Contains(t, output, "Skipped the hooks of the template")
+		assert.Contains(t, output, "failed to install dependencies")
+	})
+
+	t.Run("json", func(t *testing.T) {
+		output := setEventOutput(t)
+		events, err := newEventStream("json")
+		assert.Nil(t, err)
+
+		assert.Nil(t, events.Step("fetch_template", "Fetch the template", func() error {
+			return nil
+		}))
+		assert.EqualError(t, events.Step("init_project", "Init the project", func() error {
+			return errors.New("failed to install dependencies")
+		}), "failed to install dependencies")
+		events.Warning("Skipped the hooks of the template")
+		// The error is returned, so the command exits with a non-zero status
+		assert.EqualError(t, events.Error(errors.New("failed to install dependencies")), "failed to install dependencies")
+		events.Set("path", "/srv/blog")
+		events.Summary()
+
+		lines := readEvents(t, output)
+		if !assert.Len(t, lines, 7) {
+			return
+		}
+		assert.Equal(t, "step_started", lines[0]["event"])
+		assert.Equal(t, "fetch_template", lines[0]["step"])
+		assert.Equal(t, "Fetch the template", lines[0]["message"])
+		assert.NotEmpty(t, lines[0]["time"])
+		assert.Equal(t, "step_finished", lines[1]["event"])
+		assert.Equal(t, "succeeded", lines[1]["status"])
+		assert.Contains(t, lines[1], "duration_ms")
+		assert.NotContains(t, lines[1], "error")
+		assert.Equal(t, "step_finished", lines[3]["event"])
+		assert.Equal(t, "failed", lines[3]["status"])
+		assert.Equal(t, "failed to install dependencies", lines[3]["error"])
+		assert.Equal(t, "warning", lines[4]["event"])
+		assert.Equal(t, "Skipped the hooks of the template", lines[4]["message"])
+		assert.Equal(t, "error", lines[5]["event"])
+		assert.Equal(t, "failed to install dependencies", lines[5]["message"])
+		assert.Equal(t, "summary", lines[6]["event"])
+		assert.Equal(t, "/srv/blog", lines[6]["path"])
+		assert.Contains(t, lines[6], "duration_ms")
+	})
+}
+
+func TestEventStreamSilence(t *testing.T) {
+	output := setEventOutput(t)
+	events, err := newEventStream("json")
+	assert.Nil(t, err)
+
+	assert.EqualError(t, events.Silence(func() error {
+		color.Successln("Installed dependencies")
+		events.Summary()
+
+		return errors.New("failed")
+	}), "failed")
+
+	lines := readEvents(t, output)
+	if !assert.Len(t, lines, 1) {
+		return
+	}
+	assert.Equal(t, "summary", lines[0]["event"])
+}
+
+func setEventOutput(t *testing.T) *bytes.Buffer {
+	t.Helper()
+
+	var output bytes.Buffer
+	original := eventOutput
+	eventOutput = &output
+	t.Cleanup(func() {
+		eventOutput = original
+	})
+
+	return &output
+}
+
+func readEvents(t *testing.T, output *bytes.Buffer) []map[string]any {
+	t.Helper()
+
+	var events []map[string]any
+	for _, line := range strings.Split(strings.TrimSpace(output.String()), "\n") {
+		var event map[string]any
+		assert.Nil(t, json.Unmarshal([]byte(line), &event), line)
+		events = append(events, event)
+	}
+
+	return events
+}
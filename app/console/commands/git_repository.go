@@ -0,0 +1,66 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/goravel/framework/support/color"
+
+	"github.com/goravel/installer/app/facades"
+)
+
+// gitRepositoryOptions describes the git repository created in a new project.
+type gitRepositoryOptions struct {
+	Branch string
+	Remote string
+}
+
+// checkGitIdentity Verify that git can create commits, it needs both user.name and user.email.
+func checkGitIdentity() error {
+	if !gitInstalled() {
+		return errors.New("git is required to initialize the repository, install it or remove the --git option")
+	}
+
+	var missing []string
+	for _, key := range []string{"user.name", "user.email"} {
+		if res := facades.Process().Quietly().Run("git", "config", key); res.Failed() || strings.TrimSpace(res.Output()) == "" {
+			missing = append(missing, key)
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("git %s is not configured, it's required to create the initial commit. Set it with:\n"+
+			"  git config --global user.name \"Your Name\"\n"+
+			"  git config --global user.email \"you@example.com\"", strings.Join(missing, " and "))
+	}
+
+	return nil
+}
+
+// initGitRepository Initialize a git repository in the path, commit every file and add the origin remote.
+func initGitRepository(path, message string, options gitRepositoryOptions) error {
+	for _, command := range gitRepositoryCommands(message, options) {
+		if res := newProcess().Quietly().Path(path).Run("git", command...); res.Failed() {
+			return fmt.Errorf("failed to initialize the git repository, git %s: %s", command[0], res.Error())
+		}
+	}
+
+	color.Successln("Initialized a git repository with an initial commit")
+
+	return nil
+}
+
+// gitRepositoryCommands Get the arguments of the git commands that initialize the repository.
+func gitRepositoryCommands(message string, options gitRepositoryOptions) [][]string {
+	args := []string{"init"}
+	if options.Branch != "" {
+		args = append(args, "--initial-branch="+options.Branch)
+	}
+
+	commands := [][]string{args, {"add", "--all"}, {"commit", "--quiet", "--message", message}}
+	if options.Remote != "" {
+		commands = append(commands, []string{"remote", "add", "origin", options.Remote})
+	}
+
+	return commands
+}
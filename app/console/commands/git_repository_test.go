@@ -0,0 +1,81 @@
+package commands
+
+import (
+	"testing"
+
+	mocksprocess "github.com/goravel/framework/mocks/process"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckGitIdentity(t *testing.T) {
+	t.Run("git is not installed", func(t *testing.T) {
+		setGitInstalled(t, false)
+
+		assert.ErrorContains(t, checkGitIdentity(), "git is required to initialize the repository")
+	})
+
+	t.Run("identity is configured", func(t *testing.T) {
+		setGitInstalled(t, true)
+		mockProcess := mockNewProcess()
+		mockProcess.EXPECT().Quietly().Return(mockProcess).Twice()
+		mockNameResult := mocksprocess.NewResult(t)
+		mockNameResult.EXPECT().Failed().Return(false).Once()
+		mockNameResult.EXPECT().Output().Return("Jane Doe\n").Once()
+		mockProcess.EXPECT().Run("git", "config", "user.name").Return(mockNameResult).Once()
+		mockEmailResult := mocksprocess.NewResult(t)
+		mockEmailResult.EXPECT().Failed().Return(false).Once()
+		mockEmailResult.EXPECT().Output().Return("jane@example.com\n").Once()
+		mockProcess.EXPECT().Run("git", "config", "user.email").Return(mockEmailResult).Once()
+
+		assert.Nil(t, checkGitIdentity())
+	})
+
+	t.Run("email is missing", func(t *testing.T) {
+		setGitInstalled(t, true)
+		mockProcess := mockNewProcess()
+		mockProcess.EXPECT().Quietly().Return(mockProcess).Twice()
+		mockNameResult := mocksprocess.NewResult(t)
+		mockNameResult.EXPECT().Failed().Return(false).Once()
+		mockNameResult.EXPECT().Output().Return("Jane Doe\n").Once()
+		mockProcess.EXPECT().Run("git", "config", "user.name").Return(mockNameResult).Once()
+		mockEmailResult := mocksprocess.NewResult(t)
+		mockEmailResult.EXPECT().Failed().Return(true).Once()
+		mockProcess.EXPECT().Run("git", "config", "user.email").Return(mockEmailResult).Once()
+
+		err := checkGitIdentity()
+		assert.ErrorContains(t, err, "git user.email is not configured")
+		assert.ErrorContains(t, err, `git config --global user.email "you@example.com"`)
+	})
+}
+
+func TestInitGitRepository(t *testing.T) {
+	t.Run("with branch and remote", func(t *testing.T) {
+		mockProcess := mockNewProcess()
+		mockProcess.EXPECT().Quietly().Return(mockProcess).Times(4)
+		mockProcess.EXPECT().Path("project").Return(mockProcess).Times(4)
+		mockResult := mocksprocess.NewResult(t)
+		mockResult.EXPECT().Failed().Return(false).Times(4)
+		mockProcess.EXPECT().Run("git", "init", "--initial-branch=main").Return(mockResult).Once()
+		mockProcess.EXPECT().Run("git", "add", "--all").Return(mockResult).Once()
+		mockProcess.EXPECT().Run("git", "commit", "--quiet", "--message", "Initial commit").Return(mockResult).Once()
+		mockProcess.EXPECT().Run("git", "remote", "add", "origin", "git@github.com:acme/blog.git").Return(mockResult).Once()
+
+		assert.Nil(t, initGitRepository("project", "Initial commit", gitRepositoryOptions{Branch: "main", Remote: "git@github.com:acme/blog.git"}))
+	})
+
+	t.Run("commit fails", func(t *testing.T) {
+		mockProcess := mockNewProcess()
+		mockProcess.EXPECT().Quietly().Return(mockProcess).Times(3)
+		mockProcess.EXPECT().Path("project").Return(mockProcess).Times(3)
+		mockResult := mocksprocess.NewResult(t)
+		mockResult.EXPECT().Failed().Return(false).Twice()
+		mockProcess.EXPECT().Run("git", "init").Return(mockResult).Once()
+		mockProcess.EXPECT().Run("git", "add", "--all").Return(mockResult).Once()
+		mockCommitResult := mocksprocess.NewResult(t)
+		mockCommitResult.EXPECT().Failed().Return(true).Once()
+		mockCommitResult.EXPECT().Error().Return(assert.AnError).Once()
+		mockProcess.EXPECT().Run("git", "commit", "--quiet", "--message", "Initial commit").Return(mockCommitResult).Once()
+
+		assert.ErrorContains(t, initGitRepository("project", "Initial commit", gitRepositoryOptions{}), "failed to initialize the git repository, git commit")
+	})
+}
@@ -0,0 +1,110 @@
+package commands
+
+import (
+	"fmt"
+	"strings"
+
+	contractsprocess "github.com/goravel/framework/contracts/process"
+	"github.com/goravel/framework/support/color"
+)
+
+// goModuleOptions describes how the dependencies of a new project are installed.
+type goModuleOptions struct {
+	Proxy       string
+	Private     string
+	SkipInstall bool
+	Vendor      bool
+}
+
+// Env Get the environment of the go commands, GOPROXY and GOPRIVATE are only set when they are given.
+func (r goModuleOptions) Env() map[string]string {
+	env := make(map[string]string)
+	if r.Proxy != "" {
+		env["GOPROXY"] = r.Proxy
+	}
+	if r.Private != "" {
+		env["GOPRIVATE"] = r.Private
+	}
+
+	return env
+}
+
+// Command Format a go command with its environment, e.g. GOPROXY=https://goproxy.example.com go mod tidy.
+func (r goModuleOptions) Command(args ...string) string {
+	var prefix []string
+	for _, key := range []string{"GOPROXY", "GOPRIVATE"} {
+		if value, ok := r.Env()[key]; ok {
+			prefix = append(prefix, key+"="+value)
+		}
+	}
+
+	return strings.TrimSpace(strings.Join(prefix, " ") + " " + formatCommand("go", args...))
+}
+
+// Process Get a process that runs go in the path with the environment.
+func (r goModuleOptions) Process(path, spinner string) contractsprocess.Process {
+	return r.WithEnv(newProcess().WithSpinner(spinner).Path(path))
+}
+
+// WithEnv Set the environment of the go commands on the process, e.g. for the artisan commands that run go get.
+func (r goModuleOptions) WithEnv(process contractsprocess.Process) contractsprocess.Process {
+	if env := r.Env(); len(env) > 0 {
+		process = process.Env(env)
+	}
+
+	return process
+}
+
+// installDependencies Run go mod tidy in the project.
+func installDependencies(path string, options goModuleOptions, plan *projectPlan) error {
+	return plan.Run(options.Command("mod", "tidy"), func() error {
+		if res := options.Process(path, "Installing dependencies").Run("go", "mod", "tidy"); res.Failed() {
+			return fmt.Errorf("failed to install dependencies: %s", res.Error())
+		}
+
+		color.Successln("Installed dependencies")
+
+		return nil
+	})
+}
+
+// vendorDependencies Run go mod vendor in the project. It's the last go command of the creation, package:install
+// changes go.mod, so vendor/modules.txt would no longer match it.
+func vendorDependencies(path string, options goModuleOptions, plan *projectPlan) error {
+	return plan.Run(options.Command("mod", "vendor"), func() error {
+		if res := options.Process(path, "Vendoring dependencies").Run("go", "mod", "vendor"); res.Failed() {
+			return fmt.Errorf("failed to vendor dependencies: %s", res.Error())
+		}
+
+		color.Successln("Vendored dependencies")
+
+		return nil
+	})
+}
+
+// skippedInstallCommands Get the commands to run in a project created with --skip-install, in order.
+func skippedInstallCommands(options projectOptions) []string {
+	goModule := options.GoModule
+	commands := []string{
+		goModule.Command("mod", "tidy"),
+		goModule.Command("run", ".", "artisan", "key:generate"),
+	}
+
+	if options.Type.InstallFacades {
+		if len(options.Facades) > 0 {
+			commands = append(commands, goModule.Command(append([]string{"run", ".", "artisan", "package:install", "--default"}, options.Facades...)...))
+		} else {
+			commands = append(commands, goModule.Command("run", ".", "artisan", "package:install"))
+		}
+
+		if driver, err := getDatabaseDriver(options.Database); err == nil && driver.Package != "" {
+			commands = append(commands, goModule.Command("run", ".", "artisan", "package:install", driver.Package))
+		}
+	}
+
+	if goModule.Vendor {
+		commands = append(commands, goModule.Command("mod", "vendor"))
+	}
+
+	return commands
+}
@@ -0,0 +1,59 @@
+package commands
+
+import (
+	"io"
+	"testing"
+
+	mocksprocess "github.com/goravel/framework/mocks/process"
+	"github.com/goravel/framework/support/color"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGoModuleOptions(t *testing.T) {
+	options := goModuleOptions{Proxy: "https://goproxy.example.com,direct", Private: "github.com/acme/*"}
+
+	assert.Equal(t, map[string]string{"GOPROXY": "https://goproxy.example.com,direct", "GOPRIVATE": "github.com/acme/*"}, options.Env())
+	assert.Equal(t, "GOPROXY=https://goproxy.example.com,direct GOPRIVATE=github.com/acme/* go mod tidy", options.Command("mod", "tidy"))
+	assert.Empty(t, goModuleOptions{}.Env())
+	assert.Equal(t, "go mod vendor", goModuleOptions{}.Command("mod", "vendor"))
+}
+
+func TestSkippedInstallCommands(t *testing.T) {
+	assert.Equal(t, []string{
+		"go mod tidy",
+		"go run . artisan key:generate",
+	}, skippedInstallCommands(projectOptions{Database: "postgres", GoModule: goModuleOptions{SkipInstall: true}}))
+
+	assert.Equal(t, []string{
+		"GOPROXY=off go mod tidy",
+		"GOPROXY=off go run . artisan key:generate",
+		"GOPROXY=off go run . artisan package:install --default Route Cache",
+		"GOPROXY=off go run . artisan package:install github.com/goravel/postgres",
+		"GOPROXY=off go mod vendor",
+	}, skippedInstallCommands(projectOptions{
+		Database: "postgres",
+		Facades:  []string{"Route", "Cache"},
+		GoModule: goModuleOptions{Proxy: "off", SkipInstall: true, Vendor: true},
+		Type:     projectTypeEntry{InstallFacades: true},
+	}))
+
+	assert.Equal(t, []string{
+		"go mod tidy",
+		"go run . artisan key:generate",
+		"go run . artisan package:install",
+	}, skippedInstallCommands(projectOptions{GoModule: goModuleOptions{SkipInstall: true}, Type: projectTypeEntry{InstallFacades: true}}))
+}
+
+func TestVendorDependencies(t *testing.T) {
+	mockProcess := mockNewProcess()
+	mockProcess.EXPECT().WithSpinner("Vendoring dependencies").Return(mockProcess).Once()
+	mockProcess.EXPECT().Path("project").Return(mockProcess).Once()
+	mockProcess.EXPECT().Env(map[string]string{"GOPROXY": "off"}).Return(mockProcess).Once()
+	mockResult := mocksprocess.NewResult(t)
+	mockResult.EXPECT().Failed().Return(false).Once()
+	mockProcess.EXPECT().Run("go", "mod", "vendor").Return(mockResult).Once()
+
+	color.CaptureOutput(func(w io.Writer) {
+		assert.Nil(t, vendorDependencies("project", goModuleOptions{Proxy: "off", Vendor: true}, nil))
+	})
+}
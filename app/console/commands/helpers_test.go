@@ -0,0 +1,85 @@
+package commands
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	mocksconsole "github.com/goravel/framework/mocks/console"
+	mocksprocess "github.com/goravel/framework/mocks/process"
+	frameworkmock "github.com/goravel/framework/testing/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/urfave/cli/v3"
+)
+
+// writeFile Write a file, its parent directories are created.
+func writeFile(t *testing.T, path, content string) {
+	t.Helper()
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatalf("os.MkdirAll(%q) = %v, want nil", filepath.Dir(path), err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatalf("os.WriteFile(%q) = %v, want nil", path, err)
+	}
+}
+
+// isolateUserDirs Point the home, config and cache directories to a temp directory.
+func isolateUserDirs(t *testing.T) string {
+	t.Helper()
+
+	home := t.TempDir()
+	setHomeDir(t, home)
+	t.Setenv("XDG_CONFIG_HOME", filepath.Join(home, ".config"))
+	t.Setenv("XDG_CACHE_HOME", filepath.Join(home, ".cache"))
+	t.Setenv("AppData", filepath.Join(home, "AppData", "Roaming"))
+	t.Setenv("LocalAppData", filepath.Join(home, "AppData", "Local"))
+
+	return home
+}
+
+// parseFlags Parse the arguments with a command that has the flags.
+func parseFlags(t *testing.T, flag cli.Flag, args ...string) *cli.Command {
+	t.Helper()
+
+	command := &cli.Command{Name: "new", Flags: []cli.Flag{flag}, Action: func(context.Context, *cli.Command) error {
+		return nil
+	}}
+	assert.Nil(t, command.Run(context.Background(), append([]string{"new"}, args...)))
+
+	return command
+}
+
+// setGitInstalled Report whether git is installed for the duration of the test.
+func setGitInstalled(t *testing.T, installed bool) {
+	t.Helper()
+
+	original := gitInstalled
+	gitInstalled = func() bool {
+		return installed
+	}
+	t.Cleanup(func() {
+		gitInstalled = original
+	})
+}
+
+// mockNewProcess Mock the process facade for the steps of the new command, which bind their processes to the context
+// of the command.
+func mockNewProcess() *mocksprocess.Process {
+	mockProcess := frameworkmock.Factory().Process()
+	mockProcess.EXPECT().WithContext(mock.Anything).Return(mockProcess).Maybe()
+
+	return mockProcess
+}
+
+// mockTemplateOptions Mock the options read by generateProject to get and fetch the template.
+func mockTemplateOptions(mockContext *mocksconsole.Context, template string) {
+	mockContext.EXPECT().Option("template").Return(template).Once()
+	mockContext.EXPECT().Option("transport").Return("").Once()
+	mockContext.EXPECT().Option("sha256").Return("").Once()
+	mockContext.EXPECT().OptionBool("offline").Return(false).Once()
+	mockContext.EXPECT().Option("ref").Return("").Once()
+	mockContext.EXPECT().OptionBool("dev").Return(false).Once()
+}
@@ -0,0 +1,308 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/goravel/framework/contracts/console"
+	"github.com/goravel/framework/support/color"
+	"go.yaml.in/yaml/v3"
+)
+
+const localInstallerConfigFile = ".goravelrc"
+
+// installerConfigKey describes a key that can be stored in the installer configuration.
+type installerConfigKey struct {
+	Name     string
+	Usage    string
+	Validate func(value string) error
+}
+
+var installerConfigKeys = []installerConfigKey{
+	{Name: "mirror", Usage: "The comma-separated mirrors of the repositories: github, gitee or an HTTP(S) base URL", Validate: validateMirrorConfig},
+	{Name: "new.catalog", Usage: "The catalog of the project types, a YAML or JSON file: a local path or an HTTP(S) URL, only read from the user configuration"},
+	{Name: "new.database", Usage: "The database driver: postgres, mysql, sqlserver or sqlite", Validate: validateDatabaseConfig},
+	{Name: "new.dev", Usage: `Install the latest "development" release`, Validate: validateBoolConfig},
+	{Name: "new.docker", Usage: "Generate a Dockerfile and a docker-compose.yml in new projects", Validate: validateBoolConfig},
+	{Name: "new.git", Usage: "Initialize a git repository with an initial commit in new projects", Validate: validateBoolConfig},
+	{Name: "new.goprivate", Usage: "The GOPRIVATE of the go commands run in new projects"},
+	{Name: "new.goproxy", Usage: "The GOPROXY of the go commands run in new projects"},
+	{Name: "new.module_prefix", Usage: "The module prefix of new projects, e.g. github.com/yourusername", Validate: validateModulePrefixConfig},
+	{Name: "new.module_rename_files", Usage: "The comma-separated glob patterns of other files the module path is renamed in, e.g. *.yaml"},
+	{Name: "new.offline", Usage: "Create projects from the local template cache without network access", Validate: validateBoolConfig},
+	{Name: "new.trusted_templates", Usage: "The comma-separated templates whose hooks run without asking, only read from the user configuration"},
+	{Name: "new.type", Usage: "The project type of the catalog, e.g. goravel or lite", Validate: validateProjectTypeConfig},
+	{Name: "skill.path", Usage: "The destination skills folder"},
+}
+
+// userOnlyInstallerConfigKeys are only read from the user configuration, a .goravelrc shipped by a repository
+// can't set them.
+var userOnlyInstallerConfigKeys = []string{"new.catalog", "new.trusted_templates"}
+
+// installerConfig holds the installer defaults, project-local values take precedence over user-level ones.
+type installerConfig struct {
+	values  map[string]string
+	sources map[string]string
+}
+
+// loadInstallerConfig Load the user-level configuration file and the nearest project-local .goravelrc. The keys that
+// decide which templates are fetched and trusted are ignored in the .goravelrc, it may come with a cloned repository.
+func loadInstallerConfig() (*installerConfig, error) {
+	config := &installerConfig{
+		values:  make(map[string]string),
+		sources: make(map[string]string),
+	}
+
+	userPath, err := userInstallerConfigPath()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, path := range []string{userPath, findLocalInstallerConfig()} {
+		if path == "" {
+			continue
+		}
+
+		values, err := readInstallerConfigFile(path)
+		if err != nil {
+			return nil, err
+		}
+		for key, value := range values {
+			if path != userPath && slices.Contains(userOnlyInstallerConfigKeys, key) {
+				color.Warnln(fmt.Sprintf("Ignored %s in %s, it can only be set in the user configuration %s", key, path, userPath))
+				continue
+			}
+
+			config.values[key] = value
+			config.sources[key] = path
+		}
+	}
+
+	return config, nil
+}
+
+// Get the value of the key, returns an empty string when the config is nil or the key is not set.
+func (r *installerConfig) Get(key string) string {
+	if r == nil {
+		return ""
+	}
+
+	return r.values[key]
+}
+
+// Bool Get the boolean value of the key, returns false when the key is not set.
+func (r *installerConfig) Bool(key string) bool {
+	value, _ := strconv.ParseBool(r.Get(key))
+
+	return value
+}
+
+// Source Get the path of the file that defines the key.
+func (r *installerConfig) Source(key string) string {
+	if r == nil {
+		return ""
+	}
+
+	return r.sources[key]
+}
+
+// userInstallerConfigPath Get the path of the user-level configuration file, e.g. ~/.config/goravel/installer.yaml.
+func userInstallerConfigPath() (string, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to get user config directory: %w", err)
+	}
+
+	return filepath.Join(dir, "goravel", "installer.yaml"), nil
+}
+
+// findLocalInstallerConfig Find the nearest .goravelrc from the current directory up to the root.
+func findLocalInstallerConfig() string {
+	dir, err := os.Getwd()
+	if err != nil {
+		return ""
+	}
+
+	for {
+		path := filepath.Join(dir, localInstallerConfigFile)
+		if info, err := os.Stat(path); err == nil && !info.IsDir() {
+			return path
+		}
+
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return ""
+		}
+		dir = parent
+	}
+}
+
+func readInstallerConfigFile(path string) (map[string]string, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return map[string]string{}, nil
+		}
+
+		return nil, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+
+	var data map[string]any
+	if err := yaml.Unmarshal(content, &data); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+
+	values := make(map[string]string)
+	flattenInstallerConfig("", data, values)
+
+	return values, nil
+}
+
+func writeInstallerConfigFile(path string, values map[string]string) error {
+	data := make(map[string]any)
+	for key, value := range values {
+		parts := strings.Split(key, ".")
+		node := data
+		for _, part := range parts[:len(parts)-1] {
+			child, ok := node[part].(map[string]any)
+			if !ok {
+				child = make(map[string]any)
+				node[part] = child
+			}
+			node = child
+		}
+		node[parts[len(parts)-1]] = value
+	}
+
+	content, err := yaml.Marshal(data)
+	if err != nil {
+		return fmt.Errorf("failed to encode %s: %w", path, err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("failed to create %s: %w", filepath.Dir(path), err)
+	}
+
+	return os.WriteFile(path, content, 0644)
+}
+
+func flattenInstallerConfig(prefix string, data map[string]any, values map[string]string) {
+	for key, value := range data {
+		if prefix != "" {
+			key = prefix + "." + key
+		}
+
+		if child, ok := value.(map[string]any); ok {
+			flattenInstallerConfig(key, child, values)
+			continue
+		}
+		if value != nil {
+			values[key] = fmt.Sprint(value)
+		}
+	}
+}
+
+func getInstallerConfigKey(name string) (installerConfigKey, error) {
+	index := slices.IndexFunc(installerConfigKeys, func(key installerConfigKey) bool {
+		return key.Name == name
+	})
+	if index == -1 {
+		names := make([]string, len(installerConfigKeys))
+		for i, key := range installerConfigKeys {
+			names[i] = key.Name
+		}
+
+		return installerConfigKey{}, fmt.Errorf("unknown config key %q, available keys: %s", name, strings.Join(names, ", "))
+	}
+
+	return installerConfigKeys[index], nil
+}
+
+func validateBoolConfig(value string) error {
+	if _, err := strconv.ParseBool(value); err != nil {
+		return fmt.Errorf("invalid boolean %q, use true or false", value)
+	}
+
+	return nil
+}
+
+func validateDatabaseConfig(value string) error {
+	_, err := getDatabaseDriver(value)
+
+	return err
+}
+
+func validateMirrorConfig(value string) error {
+	_, err := parseMirrors(value)
+
+	return err
+}
+
+func validateModulePrefixConfig(value string) error {
+	if !checkModuleName(value) {
+		return fmt.Errorf("invalid module prefix %q", value)
+	}
+
+	return nil
+}
+
+// validateProjectTypeConfig Verify the project type against the catalog of the current configuration.
+func validateProjectTypeConfig(value string) error {
+	config, err := loadInstallerConfig()
+	if err != nil {
+		return err
+	}
+
+	_, err = loadProjectCatalog(config.Get("new.catalog")).Find(value)
+
+	return err
+}
+
+// configContext falls back to the installer configuration when an option is not passed on the command line.
+type configContext struct {
+	console.Context
+	config  *installerConfig
+	options map[string]string
+}
+
+// newConfigContext Wrap the context, options maps a command option to its installer configuration key.
+func newConfigContext(ctx console.Context, config *installerConfig, options map[string]string) console.Context {
+	return &configContext{
+		Context: ctx,
+		config:  config,
+		options: options,
+	}
+}
+
+func (r *configContext) Option(key string) string {
+	if value := r.Context.Option(key); value != "" {
+		return value
+	}
+	if configKey, ok := r.options[key]; ok {
+		return r.config.Get(configKey)
+	}
+
+	return ""
+}
+
+// OptionBool Get the boolean option, the config value is used unless the option is passed on the command line, so
+// --offline=false overrides a new.offline set to true.
+func (r *configContext) OptionBool(key string) bool {
+	configKey, ok := r.options[key]
+	if !ok || r.config.Get(configKey) == "" || r.isSet(key) {
+		return r.Context.OptionBool(key)
+	}
+
+	return r.config.Bool(configKey)
+}
+
+// isSet Report whether the option is passed on the command line.
+func (r *configContext) isSet(key string) bool {
+	instance := r.Context.Instance()
+
+	return instance != nil && instance.IsSet(key)
+}
@@ -0,0 +1,139 @@
+package commands
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	mocksconsole "github.com/goravel/framework/mocks/console"
+	"github.com/goravel/framework/support/color"
+	"github.com/stretchr/testify/assert"
+	"github.com/urfave/cli/v3"
+)
+
+func TestLoadInstallerConfig(t *testing.T) {
+	isolateUserDirs(t)
+	userPath, err := userInstallerConfigPath()
+	assert.Nil(t, err)
+	writeFile(t, userPath, "new:\n  type: lite\n  module_prefix: github.com/acme\nskill:\n  path: ~/skills\n")
+
+	projectDir := t.TempDir()
+	localPath := filepath.Join(projectDir, localInstallerConfigFile)
+	writeFile(t, localPath, "new:\n  type: goravel\n  dev: true\n")
+	workDir := filepath.Join(projectDir, "services")
+	assert.Nil(t, os.MkdirAll(workDir, 0755))
+	t.Chdir(workDir)
+
+	config, err := loadInstallerConfig()
+	assert.Nil(t, err)
+	assert.Equal(t, "goravel", config.Get("new.type"))
+	assert.Equal(t, localPath, config.Source("new.type"))
+	assert.Equal(t, "github.com/acme", config.Get("new.module_prefix"))
+	assert.Equal(t, userPath, config.Source("new.module_prefix"))
+	assert.Equal(t, "~/skills", config.Get("skill.path"))
+	assert.True(t, config.Bool("new.dev"))
+	assert.Equal(t, "", config.Get("missing"))
+}
+
+func TestLoadInstallerConfigUserOnlyKeys(t *testing.T) {
+	isolateUserDirs(t)
+	userPath, err := userInstallerConfigPath()
+	assert.Nil(t, err)
+	writeFile(t, userPath, "new:\n  trusted_templates: https://github.com/acme/skeleton\n")
+
+	// A cloned repository can't trust a template or replace the catalog
+	projectDir := t.TempDir()
+	localPath := filepath.Join(projectDir, localInstallerConfigFile)
+	writeFile(t, localPath, "new:\n  trusted_templates: https://github.com/evil/skeleton\n  catalog: ./catalog.yaml\n  dev: true\n")
+	t.Chdir(projectDir)
+
+	var config *installerConfig
+	captureOutput := color.CaptureOutput(func(w io.Writer) {
+		config, err = loadInstallerConfig()
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "https://github.com/acme/skeleton", config.Get("new.trusted_templates"))
+	assert.Equal(t, userPath, config.Source("new.trusted_templates"))
+	assert.Equal(t, "", config.Get("new.catalog"))
+	assert.True(t, config.Bool("new.dev"))
+	assert.Contains(t, captureOutput, "Ignored new.trusted_templates in "+localPath)
+	assert.Contains(t, captureOutput, "Ignored new.catalog in "+localPath)
+	assert.False(t, isTrustedTemplate("https://github.com/evil/skeleton", parseTrustedTemplates(config.Get("new.trusted_templates"))))
+}
+
+func TestLoadInstallerConfigInvalidFile(t *testing.T) {
+	isolateUserDirs(t)
+	t.Chdir(t.TempDir())
+	userPath, err := userInstallerConfigPath()
+	assert.Nil(t, err)
+	writeFile(t, userPath, "new: [")
+
+	config, err := loadInstallerConfig()
+	assert.ErrorContains(t, err, "failed to parse "+userPath)
+	assert.Nil(t, config)
+}
+
+func TestWriteInstallerConfigFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "goravel", "installer.yaml")
+	values := map[string]string{
+		"new.type":   "lite",
+		"skill.path": "~/skills",
+	}
+
+	assert.Nil(t, writeInstallerConfigFile(path, values))
+
+	read, err := readInstallerConfigFile(path)
+	assert.Nil(t, err)
+	assert.Equal(t, values, read)
+}
+
+func TestConfigContext(t *testing.T) {
+	config := &installerConfig{values: map[string]string{
+		"new.type": "lite",
+		"new.dev":  "true",
+	}}
+
+	t.Run("option passed on the command line", func(t *testing.T) {
+		mockContext := mocksconsole.NewContext(t)
+		mockContext.EXPECT().Option("type").Return("goravel").Once()
+
+		ctx := newConfigContext(mockContext, config, map[string]string{"type": "new.type"})
+		assert.Equal(t, "goravel", ctx.Option("type"))
+	})
+
+	t.Run("option falls back to the config", func(t *testing.T) {
+		mockContext := mocksconsole.NewContext(t)
+		mockContext.EXPECT().Option("type").Return("").Once()
+		mockContext.EXPECT().Instance().Return(parseFlags(t, &cli.BoolFlag{Name: "dev"})).Once()
+
+		ctx := newConfigContext(mockContext, config, map[string]string{"type": "new.type", "dev": "new.dev"})
+		assert.Equal(t, "lite", ctx.Option("type"))
+		assert.True(t, ctx.OptionBool("dev"))
+	})
+
+	t.Run("bool option passed on the command line overrides the config", func(t *testing.T) {
+		mockContext := mocksconsole.NewContext(t)
+		mockContext.EXPECT().Instance().Return(parseFlags(t, &cli.BoolFlag{Name: "dev"}, "--dev=false")).Once()
+		mockContext.EXPECT().OptionBool("dev").Return(false).Once()
+
+		ctx := newConfigContext(mockContext, config, map[string]string{"dev": "new.dev"})
+		assert.False(t, ctx.OptionBool("dev"))
+	})
+
+	t.Run("bool option without config value", func(t *testing.T) {
+		mockContext := mocksconsole.NewContext(t)
+		mockContext.EXPECT().OptionBool("offline").Return(true).Once()
+
+		ctx := newConfigContext(mockContext, config, map[string]string{"offline": "new.offline"})
+		assert.True(t, ctx.OptionBool("offline"))
+	})
+
+	t.Run("option without config key", func(t *testing.T) {
+		mockContext := mocksconsole.NewContext(t)
+		mockContext.EXPECT().Option("module").Return("").Once()
+
+		ctx := newConfigContext(mockContext, config, map[string]string{"type": "new.type"})
+		assert.Equal(t, "", ctx.Option("module"))
+	})
+}
@@ -0,0 +1,77 @@
+package commands
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/goravel/framework/contracts/binding"
+	frameworksupport "github.com/goravel/framework/support"
+	"github.com/goravel/framework/support/convert"
+)
+
+// availableFacades Get the facades that can be installed in a lite project, the base facades are always installed.
+// The list comes from the framework version the installer is built with, Route is listed first like package:install
+// does, so its environment variables are set up before the other facades.
+func availableFacades() []string {
+	var names []string
+	for name, info := range binding.Bindings {
+		if !info.IsBase {
+			names = append(names, convert.BindingToFacade(name))
+		}
+	}
+	slices.Sort(names)
+
+	if index := slices.Index(names, "Route"); index != -1 {
+		names = slices.Insert(slices.Delete(names, index, index+1), 0, "Route")
+	}
+
+	return names
+}
+
+// parseFacades Resolve a comma-separated list of facades, the names are case-insensitive and underscores are ignored,
+// e.g. "cache,orm,rate_limiter".
+func parseFacades(value string) ([]string, error) {
+	available := availableFacades()
+
+	selected := make(map[string]bool)
+	var invalid []string
+	for _, name := range strings.Split(value, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+
+		index := slices.IndexFunc(available, func(facade string) bool {
+			return strings.EqualFold(facade, strings.ReplaceAll(name, "_", ""))
+		})
+		if index == -1 {
+			invalid = append(invalid, name)
+			continue
+		}
+		selected[available[index]] = true
+	}
+
+	names := slices.DeleteFunc(available, func(facade string) bool {
+		return !selected[facade]
+	})
+
+	if len(invalid) > 0 {
+		return nil, fmt.Errorf("invalid facades: %s, available facades in Goravel %s: %s", strings.Join(invalid, ", "), frameworksupport.Version, strings.Join(availableFacades(), ", "))
+	}
+	if len(names) == 0 {
+		return nil, fmt.Errorf("no facade is given, available facades in Goravel %s: %s", frameworksupport.Version, strings.Join(availableFacades(), ", "))
+	}
+
+	return names, nil
+}
+
+// installSelectedFacades Install the facades without asking any question, the default driver of every facade is used.
+func installSelectedFacades(path string, names []string, options goModuleOptions) error {
+	args := append([]string{"run", ".", "artisan", "package:install", "--default"}, names...)
+	if res := options.WithEnv(newProcess().Path(path)).Run("go", args...); res.Failed() {
+		return fmt.Errorf("failed to install facades: %s", res.Error())
+	}
+
+	return nil
+}
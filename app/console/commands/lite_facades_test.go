@@ -0,0 +1,57 @@
+package commands
+
+import (
+	"testing"
+
+	mocksprocess "github.com/goravel/framework/mocks/process"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAvailableFacades(t *testing.T) {
+	facadeNames := availableFacades()
+
+	assert.Equal(t, "Route", facadeNames[0])
+	assert.Contains(t, facadeNames, "Cache")
+	assert.Contains(t, facadeNames, "RateLimiter")
+	assert.NotContains(t, facadeNames, "Artisan")
+	assert.NotContains(t, facadeNames, "Config")
+}
+
+func TestParseFacades(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    string
+		expected []string
+		err      string
+	}{
+		{name: "case-insensitive", value: "cache,ORM,Queue", expected: []string{"Cache", "Orm", "Queue"}},
+		{name: "underscores and duplicates", value: "rate_limiter, cache, Cache", expected: []string{"Cache", "RateLimiter"}},
+		{name: "route first", value: "view,route", expected: []string{"Route", "View"}},
+		{name: "invalid", value: "cache,redis,artisan", err: "invalid facades: redis, artisan, available facades in Goravel v1.18.0: Route, "},
+		{name: "empty", value: " , ", err: "no facade is given"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			facadeNames, err := parseFacades(test.value)
+			if test.err != "" {
+				assert.ErrorContains(t, err, test.err)
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, test.expected, facadeNames)
+		})
+	}
+}
+
+func TestInstallSelectedFacades(t *testing.T) {
+	mockProcess := mockNewProcess()
+	mockProcess.EXPECT().Path("project").Return(mockProcess).Once()
+	mockProcess.EXPECT().Env(map[string]string{"GOPRIVATE": "github.com/acme/*"}).Return(mockProcess).Once()
+	mockResult := mocksprocess.NewResult(t)
+	mockResult.EXPECT().Failed().Return(false).Once()
+	mockProcess.EXPECT().Run("go", "run", ".", "artisan", "package:install", "--default", "Cache", "Orm").Return(mockResult).Once()
+
+	assert.Nil(t, installSelectedFacades("project", []string{"Cache", "Orm"}, goModuleOptions{Private: "github.com/acme/*"}))
+}
@@ -0,0 +1,169 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/goravel/framework/contracts/console"
+	"github.com/goravel/framework/support/color"
+	"github.com/goravel/framework/support/file"
+)
+
+const (
+	mergeConflictKeep      = "keep"
+	mergeConflictOverwrite = "overwrite"
+	mergeConflictAbort     = "abort"
+)
+
+var mergeConflictStrategies = []string{mergeConflictKeep, mergeConflictOverwrite, mergeConflictAbort}
+
+// findMergeConflicts Get the files of the project that already exist in the target with a different content, the
+// .git directory is skipped.
+func findMergeConflicts(path, target string) ([]string, error) {
+	project, err := snapshotFiles(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var conflicts []string
+	for relativePath, checksum := range project {
+		targetPath := filepath.Join(target, filepath.FromSlash(relativePath))
+		if !verifyIfDirectoryExists(targetPath) {
+			continue
+		}
+
+		// A directory in the place of a file can't be read, it's a conflict as well
+		if targetChecksum, err := fileChecksum(targetPath); err != nil || targetChecksum != checksum {
+			conflicts = append(conflicts, relativePath)
+		}
+	}
+	slices.Sort(conflicts)
+
+	return conflicts, nil
+}
+
+// resolveMergeConflicts Decide whether to keep or overwrite each conflicting file, with the strategy of the options or
+// by asking the user. Nothing has been written to the target yet, so aborting leaves it untouched.
+func (r *NewCommand) resolveMergeConflicts(ctx console.Context, target string, conflicts []string, options projectOptions) (map[string]string, error) {
+	if len(conflicts) == 0 {
+		return nil, nil
+	}
+
+	color.Warnln(fmt.Sprintf("%d file(s) of the template already exist in %s with a different content:", len(conflicts), target))
+	for _, conflict := range conflicts {
+		color.Printfln("  %s", conflict)
+	}
+
+	strategy := options.Conflict
+	if strategy == "" && options.NoInteraction {
+		strategy = mergeConflictAbort
+	}
+
+	resolutions := make(map[string]string, len(conflicts))
+	for _, conflict := range conflicts {
+		resolution := strategy
+		if resolution == "" {
+			var err error
+			if resolution, err = ctx.Choice(fmt.Sprintf("What do you want to do with %s?", conflict), []console.Choice{
+				{Key: "Keep the existing file", Value: mergeConflictKeep},
+				{Key: "Overwrite it with the template file", Value: mergeConflictOverwrite},
+				{Key: "Abort the installation", Value: mergeConflictAbort},
+			}); err != nil {
+				return nil, err
+			}
+		}
+		if resolution == mergeConflictAbort {
+			return nil, errors.New("the installation is aborted because of the conflicting files, nothing has been written to " + target + ". Pass --conflict=keep or --conflict=overwrite to resolve them")
+		}
+
+		resolutions[conflict] = resolution
+	}
+
+	return resolutions, nil
+}
+
+// mergeProject Copy the project over the target, the conflicting files resolved as kept are skipped. The .git
+// directory of the target is always kept. Every written file is recorded in the cleanup, so the files of the target
+// are restored when the command fails or is interrupted before it completes, the created directories are removed
+// silently with their files.
+func mergeProject(path, target string, resolutions map[string]string, cleanup *projectCleanup) error {
+	err := filepath.WalkDir(path, func(filePath string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		relativePath, err := filepath.Rel(path, filePath)
+		if err != nil {
+			return err
+		}
+		targetPath := filepath.Join(target, relativePath)
+
+		if entry.IsDir() {
+			if relativePath == ".git" && verifyIfDirectoryExists(targetPath) {
+				return filepath.SkipDir
+			}
+			if verifyIfDirectoryExists(targetPath) {
+				return nil
+			}
+			if err := os.MkdirAll(targetPath, 0755); err != nil {
+				return err
+			}
+			cleanup.Add("", func() error {
+				return os.Remove(targetPath)
+			})
+
+			return nil
+		}
+		if resolutions[filepath.ToSlash(relativePath)] == mergeConflictKeep {
+			return nil
+		}
+
+		info, err := os.Stat(targetPath)
+		switch {
+		case err != nil:
+			cleanup.Add("Removed "+targetPath, func() error {
+				return os.Remove(targetPath)
+			})
+		case info.IsDir():
+			if err := os.RemoveAll(targetPath); err != nil {
+				return err
+			}
+			cleanup.Add("Removed "+targetPath, func() error {
+				return os.Remove(targetPath)
+			})
+		default:
+			content, err := os.ReadFile(targetPath)
+			if err != nil {
+				return err
+			}
+			mode := info.Mode().Perm()
+			cleanup.Add("Restored "+targetPath, func() error {
+				return os.WriteFile(targetPath, content, mode)
+			})
+		}
+
+		return file.Copy(filePath, targetPath)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to merge the project into %s: %s", target, err)
+	}
+
+	var kept []string
+	for conflict, resolution := range resolutions {
+		if resolution == mergeConflictKeep {
+			kept = append(kept, conflict)
+		}
+	}
+	color.Successln("Merged the project into " + target)
+	if len(kept) > 0 {
+		slices.Sort(kept)
+		color.Warnln("Kept the existing files: " + strings.Join(kept, ", "))
+	}
+
+	return nil
+}
@@ -0,0 +1,136 @@
+package commands
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/goravel/framework/contracts/console"
+	mocksconsole "github.com/goravel/framework/mocks/console"
+	"github.com/goravel/framework/support/color"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestFindMergeConflicts(t *testing.T) {
+	path := t.TempDir()
+	target := t.TempDir()
+	writeFile(t, filepath.Join(path, "README.md"), "# Goravel")
+	writeFile(t, filepath.Join(path, "LICENSE"), "MIT")
+	writeFile(t, filepath.Join(path, "main.go"), "package main")
+	writeFile(t, filepath.Join(path, "config", "app.go"), "package config")
+	writeFile(t, filepath.Join(target, "README.md"), "# Blog")
+	writeFile(t, filepath.Join(target, "LICENSE"), "MIT")
+	assert.Nil(t, os.MkdirAll(filepath.Join(target, "main.go"), 0755))
+
+	conflicts, err := findMergeConflicts(path, target)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"README.md", "main.go"}, conflicts)
+}
+
+func TestResolveMergeConflicts(t *testing.T) {
+	newCommand := &NewCommand{}
+	conflicts := []string{"LICENSE", "README.md"}
+
+	t.Run("no conflicts", func(t *testing.T) {
+		resolutions, err := newCommand.resolveMergeConflicts(mocksconsole.NewContext(t), "/srv/blog", nil, projectOptions{})
+		assert.Nil(t, err)
+		assert.Nil(t, resolutions)
+	})
+
+	t.Run("ask for each conflict", func(t *testing.T) {
+		mockContext := mocksconsole.NewContext(t)
+		mockContext.EXPECT().Choice("What do you want to do with LICENSE?", mock.MatchedBy(func(choices []console.Choice) bool {
+			return len(choices) == 3
+		})).Return(mergeConflictKeep, nil).Once()
+		mockContext.EXPECT().Choice("What do you want to do with README.md?", mock.Anything).Return(mergeConflictOverwrite, nil).Once()
+
+		var resolutions map[string]string
+		var err error
+		captureOutput := color.CaptureOutput(func(w io.Writer) {
+			resolutions, err = newCommand.resolveMergeConflicts(mockContext, "/srv/blog", conflicts, projectOptions{})
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, map[string]string{"LICENSE": mergeConflictKeep, "README.md": mergeConflictOverwrite}, resolutions)
+		assert.Contains(t, captureOutput, "2 file(s) of the template already exist in /srv/blog with a different content")
+		assert.Contains(t, captureOutput, "  README.md")
+	})
+
+	t.Run("abort when asked", func(t *testing.T) {
+		mockContext := mocksconsole.NewContext(t)
+		mockContext.EXPECT().Choice("What do you want to do with LICENSE?", mock.Anything).Return(mergeConflictAbort, nil).Once()
+
+		var err error
+		color.CaptureOutput(func(w io.Writer) {
+			_, err = newCommand.resolveMergeConflicts(mockContext, "/srv/blog", conflicts, projectOptions{})
+		})
+		assert.ErrorContains(t, err, "the installation is aborted because of the conflicting files")
+	})
+
+	t.Run("strategy provided", func(t *testing.T) {
+		var resolutions map[string]string
+		var err error
+		color.CaptureOutput(func(w io.Writer) {
+			resolutions, err = newCommand.resolveMergeConflicts(mocksconsole.NewContext(t), "/srv/blog", conflicts, projectOptions{Conflict: mergeConflictOverwrite})
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, map[string]string{"LICENSE": mergeConflictOverwrite, "README.md": mergeConflictOverwrite}, resolutions)
+	})
+
+	t.Run("abort without interaction", func(t *testing.T) {
+		var err error
+		color.CaptureOutput(func(w io.Writer) {
+			_, err = newCommand.resolveMergeConflicts(mocksconsole.NewContext(t), "/srv/blog", conflicts, projectOptions{NoInteraction: true})
+		})
+		assert.ErrorContains(t, err, "Pass --conflict=keep or --conflict=overwrite to resolve them")
+	})
+}
+
+func TestMergeProject(t *testing.T) {
+	path := t.TempDir()
+	target := t.TempDir()
+	writeFile(t, filepath.Join(path, "README.md"), "# Goravel")
+	writeFile(t, filepath.Join(path, "LICENSE"), "MIT")
+	writeFile(t, filepath.Join(path, "config", "app.go"), "package config")
+	writeFile(t, filepath.Join(path, ".git", "HEAD"), "ref: refs/heads/master")
+	writeFile(t, filepath.Join(target, "README.md"), "# Blog")
+	writeFile(t, filepath.Join(target, "LICENSE"), "Apache")
+	writeFile(t, filepath.Join(target, ".git", "HEAD"), "ref: refs/heads/main")
+
+	cleanup := newProjectCleanup()
+	var err error
+	captureOutput := color.CaptureOutput(func(w io.Writer) {
+		err = mergeProject(path, target, map[string]string{"README.md": mergeConflictKeep, "LICENSE": mergeConflictOverwrite}, cleanup)
+	})
+	assert.Nil(t, err)
+	assert.Contains(t, captureOutput, "Kept the existing files: README.md")
+
+	for file, content := range map[string]string{
+		"README.md":     "# Blog",
+		"LICENSE":       "MIT",
+		"config/app.go": "package config",
+		".git/HEAD":     "ref: refs/heads/main",
+	} {
+		data, err := os.ReadFile(filepath.Join(target, file))
+		assert.Nil(t, err)
+		assert.Equal(t, content, string(data))
+	}
+
+	captureOutput = color.CaptureOutput(func(w io.Writer) {
+		cleanup.Run()
+	})
+	assert.Contains(t, captureOutput, "Removed "+filepath.Join(target, "config", "app.go"))
+	assert.Contains(t, captureOutput, "Restored "+filepath.Join(target, "LICENSE"))
+	assert.NotContains(t, captureOutput, filepath.Join(target, "README.md"))
+	assert.NoDirExists(t, filepath.Join(target, "config"))
+	for file, content := range map[string]string{
+		"README.md": "# Blog",
+		"LICENSE":   "Apache",
+		".git/HEAD": "ref: refs/heads/main",
+	} {
+		data, err := os.ReadFile(filepath.Join(target, file))
+		assert.Nil(t, err)
+		assert.Equal(t, content, string(data))
+	}
+}
@@ -0,0 +1,138 @@
+package commands
+
+import (
+	"cmp"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	contractsprocess "github.com/goravel/framework/contracts/process"
+	"github.com/goravel/framework/support/color"
+)
+
+// mirrorEnv is the environment variable of the mirrors, it takes precedence over the mirror config.
+const mirrorEnv = "GORAVEL_MIRROR"
+
+// githubMirror is the origin of the GitHub repositories.
+const githubMirror = "https://github.com/"
+
+// builtinMirrors maps the names of the built-in mirrors to the base URL of their repositories.
+var builtinMirrors = map[string]string{
+	"github": githubMirror,
+	"gitee":  "https://gitee.com/",
+}
+
+// getMirrors Get the base URLs of the mirrors of the option, the GORAVEL_MIRROR environment variable or the mirror
+// config, in this order of precedence.
+func getMirrors(option string, config *installerConfig) ([]string, error) {
+	return parseMirrors(cmp.Or(option, os.Getenv(mirrorEnv), config.Get("mirror")))
+}
+
+// parseMirrors Parse comma-separated mirrors, each one is the name of a built-in mirror or the HTTP(S) base URL of
+// a mirror that serves the repositories under their GitHub path, e.g. https://git.example.com/github/.
+func parseMirrors(value string) ([]string, error) {
+	var mirrors []string
+	for _, mirror := range strings.Split(value, ",") {
+		mirror = strings.TrimSpace(mirror)
+		if mirror == "" {
+			continue
+		}
+
+		base, ok := builtinMirrors[strings.ToLower(mirror)]
+		if !ok {
+			if !isHTTPURL(mirror) {
+				return nil, fmt.Errorf("invalid mirror %q, use github, gitee or the HTTP(S) base URL of a mirror", mirror)
+			}
+			base = strings.TrimRight(mirror, "/") + "/"
+		}
+		if !slices.Contains(mirrors, base) {
+			mirrors = append(mirrors, base)
+		}
+	}
+
+	return mirrors, nil
+}
+
+// mirrorRepos Get the URLs to clone a repository from, in order. A GitHub repository is rewritten for every mirror,
+// GitHub itself is tried last unless it's one of the mirrors. Other repositories are never rewritten.
+func mirrorRepos(repo string, mirrors []string) []string {
+	matches := githubRepoRegexp.FindStringSubmatch(repo)
+	if matches == nil || len(mirrors) == 0 {
+		return []string{repo}
+	}
+
+	var repos []string
+	for _, base := range mirrors {
+		mirrored := repo
+		if base != githubMirror {
+			mirrored = base + matches[1] + "/" + matches[2] + ".git"
+		}
+		if !slices.Contains(repos, mirrored) {
+			repos = append(repos, mirrored)
+		}
+	}
+	if !slices.Contains(mirrors, githubMirror) {
+		repos = append(repos, repo)
+	}
+
+	return repos
+}
+
+// fetchFromMirrors Fetch the repository from its mirrors, the next mirror is tried when a fetch fails. The fetch is
+// told whether a next mirror is left, so it can give up on a stalled transfer.
+func fetchFromMirrors(repo string, mirrors []string, fetch func(repo string, fallback bool) error) error {
+	repos := mirrorRepos(repo, mirrors)
+
+	var err error
+	for i, mirrored := range repos {
+		fallback := i < len(repos)-1
+		if err = fetch(mirrored, fallback); err == nil || !fallback || processContext.Err() != nil {
+			break
+		}
+
+		color.Warnln(fmt.Sprintf("%s, trying the next mirror %s", err, repos[i+1]))
+	}
+
+	return err
+}
+
+// cloneFromMirrors Clone the repository from its mirrors into the path, what a failed clone left is removed before
+// the next mirror is tried.
+func cloneFromMirrors(repo string, mirrors []string, path string, clone func(repo string, fallback bool) error) error {
+	return fetchFromMirrors(repo, mirrors, func(repo string, fallback bool) error {
+		err := clone(repo, fallback)
+		if err != nil && fallback {
+			if err := os.RemoveAll(path); err != nil {
+				return fmt.Errorf("failed to remove the directory: %s", err)
+			}
+		}
+
+		return err
+	})
+}
+
+// checkArchiveMirrors Report an error when a mirror other than GitHub is set, the archives are only downloaded from
+// GitHub and the mirror would be silently skipped otherwise.
+func checkArchiveMirrors(mirrors []string) error {
+	for _, mirror := range mirrors {
+		if mirror != githubMirror {
+			return fmt.Errorf("unable to download an archive from the mirror %s, install git or use the git transport to clone from mirrors", mirror)
+		}
+	}
+
+	return nil
+}
+
+// abortStalledClone Make git give up on a clone or a listing that transfers less than 1KB/s for 30 seconds, so the next mirror is
+// tried instead of waiting on a slow or blocked host. It's only done when a next mirror is left.
+func abortStalledClone(process contractsprocess.Process, fallback bool) contractsprocess.Process {
+	if !fallback {
+		return process
+	}
+
+	return process.Env(map[string]string{
+		"GIT_HTTP_LOW_SPEED_LIMIT": "1000",
+		"GIT_HTTP_LOW_SPEED_TIME":  "30",
+	})
+}
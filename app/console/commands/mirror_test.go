@@ -0,0 +1,86 @@
+package commands
+
+import (
+	"errors"
+	"io"
+	"path/filepath"
+	"testing"
+
+	"github.com/goravel/framework/support/color"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetMirrors(t *testing.T) {
+	config := &installerConfig{values: map[string]string{"mirror": "github"}}
+	t.Setenv(mirrorEnv, "")
+
+	mirrors, err := getMirrors("", config)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{githubMirror}, mirrors)
+
+	t.Setenv(mirrorEnv, "gitee")
+	mirrors, err = getMirrors("", config)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"https://gitee.com/"}, mirrors)
+
+	mirrors, err = getMirrors("https://git.example.com/github", config)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"https://git.example.com/github/"}, mirrors)
+}
+
+func TestParseMirrors(t *testing.T) {
+	mirrors, err := parseMirrors("")
+	assert.Nil(t, err)
+	assert.Empty(t, mirrors)
+
+	mirrors, err = parseMirrors(" Gitee, https://git.example.com/github/ ,gitee,github")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"https://gitee.com/", "https://git.example.com/github/", githubMirror}, mirrors)
+
+	_, err = parseMirrors("gitlab")
+	assert.EqualError(t, err, `invalid mirror "gitlab", use github, gitee or the HTTP(S) base URL of a mirror`)
+}
+
+func TestMirrorRepos(t *testing.T) {
+	assert.Equal(t, []string{goravelRepo}, mirrorRepos(goravelRepo, nil))
+	assert.Equal(t, []string{"https://gitee.com/goravel/goravel.git", goravelRepo}, mirrorRepos(goravelRepo, []string{"https://gitee.com/"}))
+	assert.Equal(t, []string{"git@github.com:acme/blog.git", "https://gitee.com/acme/blog.git"}, mirrorRepos("git@github.com:acme/blog.git", []string{githubMirror, "https://gitee.com/"}))
+	assert.Equal(t, []string{"https://gitlab.com/acme/blog.git"}, mirrorRepos("https://gitlab.com/acme/blog.git", []string{"https://gitee.com/"}))
+}
+
+func TestCloneFromMirrors(t *testing.T) {
+	t.Run("falls back to the next mirror", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "project")
+
+		var cloned []string
+		var fallbacks []bool
+		var err error
+		captureOutput := color.CaptureOutput(func(w io.Writer) {
+			err = cloneFromMirrors(goravelRepo, []string{"https://gitee.com/"}, path, func(repo string, fallback bool) error {
+				cloned = append(cloned, repo)
+				fallbacks = append(fallbacks, fallback)
+				if fallback {
+					writeFile(t, filepath.Join(path, "README.md"), "# Goravel")
+					return errors.New("failed to clone goravel: timeout")
+				}
+
+				assert.NoDirExists(t, path)
+				return nil
+			})
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"https://gitee.com/goravel/goravel.git", goravelRepo}, cloned)
+		assert.Equal(t, []bool{true, false}, fallbacks)
+		assert.Contains(t, captureOutput, "failed to clone goravel: timeout, trying the next mirror "+goravelRepo)
+	})
+
+	t.Run("returns the error of the last mirror", func(t *testing.T) {
+		var err error
+		color.CaptureOutput(func(w io.Writer) {
+			err = cloneFromMirrors(goravelRepo, []string{"https://gitee.com/"}, t.TempDir(), func(repo string, fallback bool) error {
+				return errors.New("failed to clone " + repo)
+			})
+		})
+		assert.EqualError(t, err, "failed to clone "+goravelRepo)
+	})
+}
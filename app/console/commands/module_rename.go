@@ -0,0 +1,277 @@
+package commands
+
+import (
+	"bytes"
+	"cmp"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/dave/dst"
+	"github.com/dave/dst/decorator"
+	"golang.org/x/mod/modfile"
+
+	"github.com/goravel/installer/support"
+)
+
+// moduleRenameRule rewrites the module path in files that are not Go source files. Pattern is a regular expression
+// where {module} stands for the old module path, the text of its first and second groups is kept around the new path.
+// The package paths of the module are rewritten when the pattern is empty.
+type moduleRenameRule struct {
+	Files   []string `yaml:"files"`
+	Pattern string   `yaml:"pattern"`
+}
+
+// modulePathPattern matches the old module at the start of a package path, e.g. goravel/app in a build flag. The
+// module alone is left as is, it's usually the name of a binary or a directory there.
+const modulePathPattern = `(?m)(^|[\s"'=]){module}(/)`
+
+var moduleRenameRules = []moduleRenameRule{
+	// The module, require, replace and exclude directives, the lines of their blocks and the target of a replace
+	{Files: []string{"go.mod", "go.work"}, Pattern: `(?m)(^[ \t]*(?:(?:module|require|replace|exclude)[ \t]+)?|=>[ \t]+){module}(/|[ \t]|$)`},
+	{Files: []string{"*.proto"}, Pattern: `(go_package\s*=\s*"){module}([/;"])`},
+	{Files: []string{"Dockerfile", "Dockerfile.*", "*.dockerfile"}},
+}
+
+// moduleRenameSkippedDirs are not renamed, testdata holds fixtures that are often invalid Go on purpose.
+var moduleRenameSkippedDirs = []string{".git", "node_modules", "testdata", "vendor"}
+
+var goGenerateRule = moduleRenameRule{Pattern: `(\s){module}(/|\s|$)`}
+
+// errModuleRenameParse is wrapped by the error of a Go file that can't be parsed, the file is skipped by Rename.
+var errModuleRenameParse = errors.New("it can't be parsed")
+
+// moduleRenamer renames the module of a project, import paths are rewritten on the Go syntax tree so that the
+// formatting and comments of the files are kept. A Go file that can't be parsed is left untouched and reported in
+// skipped.
+type moduleRenamer struct {
+	oldModule string
+	newModule string
+	rules     []moduleRenameRule
+	skipped   []string
+}
+
+// parseModuleRenameFiles Get the rules of the comma-separated new.module_rename_files configuration, the package
+// paths of the module are rewritten in the files that match one of the glob patterns.
+func parseModuleRenameFiles(value string) []moduleRenameRule {
+	var files []string
+	for _, file := range strings.Split(value, ",") {
+		if file = strings.TrimSpace(file); file != "" {
+			files = append(files, file)
+		}
+	}
+	if len(files) == 0 {
+		return nil
+	}
+
+	return []moduleRenameRule{{Files: files}}
+}
+
+// validateModuleRenameRules Check the rules that don't come from the installer, so a bad pattern is reported
+// before the project is created.
+func validateModuleRenameRules(rules []moduleRenameRule) error {
+	for _, rule := range rules {
+		if len(rule.Files) == 0 {
+			return fmt.Errorf("the module rename rule %q has no files", rule.Pattern)
+		}
+		if rule.Pattern == "" {
+			continue
+		}
+		if !strings.Contains(rule.Pattern, "{module}") {
+			return fmt.Errorf("the module rename pattern %q doesn't contain {module}", rule.Pattern)
+		}
+		if _, err := regexp.Compile(strings.ReplaceAll(rule.Pattern, "{module}", "goravel")); err != nil {
+			return fmt.Errorf("invalid module rename pattern %q: %s", rule.Pattern, err)
+		}
+	}
+
+	return nil
+}
+
+func newModuleRenamer(oldModule, newModule string, rules []moduleRenameRule) *moduleRenamer {
+	return &moduleRenamer{
+		oldModule: oldModule,
+		newModule: newModule,
+		rules:     rules,
+	}
+}
+
+// getProjectModule Get the module path declared in the go.mod file of the project.
+func getProjectModule(path string) string {
+	content, err := os.ReadFile(filepath.Join(path, "go.mod"))
+	if err != nil {
+		return support.DefaultModuleName
+	}
+	if module := modfile.ModulePath(content); module != "" {
+		return module
+	}
+
+	return support.DefaultModuleName
+}
+
+// Skipped Get the Go files that couldn't be parsed by Rename, each one is relative to the path and followed by the
+// parse error.
+func (r *moduleRenamer) Skipped() []string {
+	return r.skipped
+}
+
+// Rename Rename the module in every file of the project, returns the changed files relative to the path.
+func (r *moduleRenamer) Rename(path string) ([]string, error) {
+	var changed []string
+	err := filepath.WalkDir(path, func(filePath string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if entry.IsDir() {
+			if filePath != path && slices.Contains(moduleRenameSkippedDirs, entry.Name()) {
+				return filepath.SkipDir
+			}
+
+			return nil
+		}
+
+		relativePath, err := filepath.Rel(path, filePath)
+		if err != nil {
+			return err
+		}
+
+		modified, err := r.renameFile(filePath)
+		if errors.Is(err, errModuleRenameParse) {
+			r.skipped = append(r.skipped, fmt.Sprintf("%s, %s", filepath.ToSlash(relativePath), err))
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if modified {
+			changed = append(changed, filepath.ToSlash(relativePath))
+		}
+
+		return nil
+	})
+
+	return changed, err
+}
+
+func (r *moduleRenamer) renameFile(filePath string) (bool, error) {
+	var rules []moduleRenameRule
+	name := filepath.Base(filePath)
+	for _, rule := range r.rules {
+		if slices.ContainsFunc(rule.Files, func(pattern string) bool {
+			matched, _ := filepath.Match(pattern, name)
+			return matched
+		}) {
+			rules = append(rules, rule)
+		}
+	}
+	if !strings.HasSuffix(name, ".go") && len(rules) == 0 {
+		return false, nil
+	}
+
+	content, err := os.ReadFile(filePath)
+	if err != nil {
+		return false, fmt.Errorf("error reading %s: %w", filePath, err)
+	}
+
+	newContent := content
+	if strings.HasSuffix(name, ".go") {
+		if newContent, err = r.renameGoFile(content); err != nil {
+			return false, fmt.Errorf("%w: %w", errModuleRenameParse, err)
+		}
+	}
+	for _, rule := range rules {
+		newContent = []byte(r.replace(rule, string(newContent)))
+	}
+
+	if bytes.Equal(content, newContent) {
+		return false, nil
+	}
+
+	info, err := os.Stat(filePath)
+	if err != nil {
+		return false, err
+	}
+
+	return true, os.WriteFile(filePath, newContent, info.Mode().Perm())
+}
+
+// renameGoFile Rewrite the import paths and the //go:generate directives of a Go file, the content is returned
+// unchanged when nothing refers to the old module.
+func (r *moduleRenamer) renameGoFile(content []byte) ([]byte, error) {
+	file, err := decorator.Parse(content)
+	if err != nil {
+		return nil, err
+	}
+
+	var modified bool
+	dst.Inspect(file, func(node dst.Node) bool {
+		if node == nil {
+			return false
+		}
+
+		if spec, ok := node.(*dst.ImportSpec); ok {
+			if importPath, err := strconv.Unquote(spec.Path.Value); err == nil && r.isOldModulePath(importPath) {
+				spec.Path.Value = strconv.Quote(r.newModule + strings.TrimPrefix(importPath, r.oldModule))
+				modified = true
+			}
+		}
+
+		if decorations := node.Decorations(); decorations != nil {
+			for _, decoration := range []*dst.Decorations{&decorations.Start, &decorations.End} {
+				if r.renameGoGenerate(*decoration) {
+					modified = true
+				}
+			}
+		}
+
+		return true
+	})
+	for _, decoration := range []dst.Decorations{file.Decs.Package, file.Decs.Name} {
+		if r.renameGoGenerate(decoration) {
+			modified = true
+		}
+	}
+
+	if !modified {
+		return content, nil
+	}
+
+	var buffer bytes.Buffer
+	if err := decorator.Fprint(&buffer, file); err != nil {
+		return nil, err
+	}
+
+	return buffer.Bytes(), nil
+}
+
+func (r *moduleRenamer) renameGoGenerate(decorations dst.Decorations) bool {
+	var modified bool
+	for i, decoration := range decorations {
+		if !strings.HasPrefix(decoration, "//go:generate ") {
+			continue
+		}
+
+		if renamed := r.replace(goGenerateRule, decoration); renamed != decoration {
+			decorations[i] = renamed
+			modified = true
+		}
+	}
+
+	return modified
+}
+
+func (r *moduleRenamer) replace(rule moduleRenameRule, content string) string {
+	pattern := regexp.MustCompile(strings.ReplaceAll(cmp.Or(rule.Pattern, modulePathPattern), "{module}", regexp.QuoteMeta(r.oldModule)))
+
+	return pattern.ReplaceAllString(content, "${1}"+strings.ReplaceAll(r.newModule, "$", "$$")+"${2}")
+}
+
+func (r *moduleRenamer) isOldModulePath(importPath string) bool {
+	return importPath == r.oldModule || strings.HasPrefix(importPath, r.oldModule+"/")
+}
@@ -0,0 +1,147 @@
+package commands
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetProjectModule(t *testing.T) {
+	path := t.TempDir()
+	assert.Equal(t, "goravel", getProjectModule(path))
+
+	writeFile(t, filepath.Join(path, "go.mod"), "module github.com/acme/skeleton\n\ngo 1.25\n")
+	assert.Equal(t, "github.com/acme/skeleton", getProjectModule(path))
+}
+
+func TestModuleRenamer(t *testing.T) {
+	path := t.TempDir()
+	files := map[string]string{
+		"go.mod":  "module goravel\n\ngo 1.25\n\n// The goravel tools\nrequire github.com/goravel/framework v1.18.0\n\nreplace goravel/tools => ./tools\n",
+		"go.work": "go 1.25\n\nuse .\n\nreplace goravel => ./\n",
+		"main.go": `package main
+
+//go:generate go run goravel/cmd/generate -pkg goravel/app
+
+import (
+	"fmt"
+
+	// The application bootstrap.
+	"goravel"
+	app "goravel/app"
+	"goravel/bootstrap"
+	"goraveler/extra"
+)
+
+func main() {
+	fmt.Println("goravel/app", app.Name, goravel.Name)
+	bootstrap.Boot()
+}
+`,
+		"untouched.go":                       "package main\n\nvar name = \"goravel/app\"\n",
+		"proto/user.proto":                   "syntax = \"proto3\";\n\noption go_package = \"goravel/proto/user;user\";\n",
+		"Dockerfile":                         "FROM golang:1.25\nRUN go build -ldflags \"-X goravel/app.Version=1\" -o goravel .\nCMD [\"./goravel\"]\n",
+		"deploy/values.yaml":                 "image: goravel\nenv:\n  PACKAGE: goravel/app\n",
+		"README.md":                          "import \"goravel/app\"\n",
+		"vendor/goravel/app/app.go":          "package app\n\nimport \"goravel/bootstrap\"\n",
+		"node_modules/pkg/index.go":          "package pkg\n\nimport \"goravel/bootstrap\"\n",
+		"app/testdata/invalid.go":            "package testdata\n\nimport \"goravel/bootstrap\"\n\nfunc {\n",
+		"app/http/controllers/controller.go": "package controllers\n\nimport \"goravel/app/facades\"\n\nvar _ = facades.Name\n",
+	}
+	for name, content := range files {
+		writeFile(t, filepath.Join(path, name), content)
+	}
+
+	changed, err := newModuleRenamer("goravel", "github.com/acme/blog", moduleRenameRules).Rename(path)
+	assert.Nil(t, err)
+	assert.ElementsMatch(t, []string{"go.mod", "go.work", "main.go", "proto/user.proto", "Dockerfile", "app/http/controllers/controller.go"}, changed)
+
+	assertFileContent := func(name, expected string) {
+		t.Helper()
+
+		content, err := os.ReadFile(filepath.Join(path, name))
+		assert.Nil(t, err)
+		assert.Equal(t, expected, string(content))
+	}
+
+	assertFileContent("go.mod", "module github.com/acme/blog\n\ngo 1.25\n\n// The goravel tools\nrequire github.com/goravel/framework v1.18.0\n\nreplace github.com/acme/blog/tools => ./tools\n")
+	assertFileContent("go.work", "go 1.25\n\nuse .\n\nreplace github.com/acme/blog => ./\n")
+	assertFileContent("main.go", `package main
+
+//go:generate go run github.com/acme/blog/cmd/generate -pkg github.com/acme/blog/app
+
+import (
+	"fmt"
+
+	// The application bootstrap.
+	"github.com/acme/blog"
+	app "github.com/acme/blog/app"
+	"github.com/acme/blog/bootstrap"
+	"goraveler/extra"
+)
+
+func main() {
+	fmt.Println("goravel/app", app.Name, goravel.Name)
+	bootstrap.Boot()
+}
+`)
+	assertFileContent("untouched.go", files["untouched.go"])
+	assertFileContent("proto/user.proto", "syntax = \"proto3\";\n\noption go_package = \"github.com/acme/blog/proto/user;user\";\n")
+	assertFileContent("Dockerfile", "FROM golang:1.25\nRUN go build -ldflags \"-X github.com/acme/blog/app.Version=1\" -o goravel .\nCMD [\"./goravel\"]\n")
+	assertFileContent("deploy/values.yaml", files["deploy/values.yaml"])
+	assertFileContent("README.md", files["README.md"])
+	assertFileContent("vendor/goravel/app/app.go", files["vendor/goravel/app/app.go"])
+	assertFileContent("node_modules/pkg/index.go", files["node_modules/pkg/index.go"])
+	assertFileContent("app/testdata/invalid.go", files["app/testdata/invalid.go"])
+}
+
+func TestModuleRenamerInvalidGoFile(t *testing.T) {
+	path := t.TempDir()
+	writeFile(t, filepath.Join(path, "main.go"), "package main\n\nimport (\n")
+	writeFile(t, filepath.Join(path, "app/app.go"), "package app\n\nimport \"goravel/bootstrap\"\n")
+
+	renamer := newModuleRenamer("goravel", "github.com/acme/blog", moduleRenameRules)
+	changed, err := renamer.Rename(path)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"app/app.go"}, changed)
+	if assert.Len(t, renamer.Skipped(), 1) {
+		assert.Contains(t, renamer.Skipped()[0], "main.go, it can't be parsed: ")
+	}
+
+	content, err := os.ReadFile(filepath.Join(path, "main.go"))
+	assert.Nil(t, err)
+	assert.Equal(t, "package main\n\nimport (\n", string(content))
+}
+
+func TestModuleRenamerExtraRules(t *testing.T) {
+	path := t.TempDir()
+	writeFile(t, filepath.Join(path, "deploy/values.yaml"), "image: goravel\nenv:\n  PACKAGE: goravel/app\n")
+	writeFile(t, filepath.Join(path, "Makefile"), "MODULE := goravel\nbuild:\n\tgo build -o goravel .\n")
+
+	rules := append(parseModuleRenameFiles(" *.yaml, "), moduleRenameRule{Files: []string{"Makefile"}, Pattern: `(MODULE := ){module}()`})
+	changed, err := newModuleRenamer("goravel", "github.com/acme/blog", rules).Rename(path)
+	assert.Nil(t, err)
+	assert.ElementsMatch(t, []string{"deploy/values.yaml", "Makefile"}, changed)
+
+	content, err := os.ReadFile(filepath.Join(path, "deploy/values.yaml"))
+	assert.Nil(t, err)
+	assert.Equal(t, "image: goravel\nenv:\n  PACKAGE: github.com/acme/blog/app\n", string(content))
+
+	content, err = os.ReadFile(filepath.Join(path, "Makefile"))
+	assert.Nil(t, err)
+	assert.Equal(t, "MODULE := github.com/acme/blog\nbuild:\n\tgo build -o goravel .\n", string(content))
+}
+
+func TestParseModuleRenameFiles(t *testing.T) {
+	assert.Nil(t, parseModuleRenameFiles(" , "))
+	assert.Equal(t, []moduleRenameRule{{Files: []string{"*.yaml", "Makefile"}}}, parseModuleRenameFiles("*.yaml, Makefile"))
+}
+
+func TestValidateModuleRenameRules(t *testing.T) {
+	assert.Nil(t, validateModuleRenameRules([]moduleRenameRule{{Files: []string{"*.yaml"}}, {Files: []string{"Makefile"}, Pattern: `(:= ){module}()`}}))
+	assert.EqualError(t, validateModuleRenameRules([]moduleRenameRule{{Pattern: `{module}`}}), `the module rename rule "{module}" has no files`)
+	assert.EqualError(t, validateModuleRenameRules([]moduleRenameRule{{Files: []string{"Makefile"}, Pattern: `MODULE`}}), `the module rename pattern "MODULE" doesn't contain {module}`)
+	assert.ErrorContains(t, validateModuleRenameRules([]moduleRenameRule{{Files: []string{"Makefile"}, Pattern: `({module}`}}), `invalid module rename pattern "({module}"`)
+}
@@ -1,27 +1,73 @@
 package commands
 
 import (
-	"bufio"
+	"cmp"
+	"context"
 	"fmt"
+	"maps"
 	"os"
 	"path/filepath"
 	"regexp"
 	"slices"
 	"strings"
+	"time"
 
 	"github.com/goravel/framework/contracts/console"
 	"github.com/goravel/framework/contracts/console/command"
 	"github.com/goravel/framework/errors"
+	frameworksupport "github.com/goravel/framework/support"
 	"github.com/goravel/framework/support/color"
 	"github.com/goravel/framework/support/file"
+	"golang.org/x/term"
 
-	"github.com/goravel/installer/app/facades"
 	"github.com/goravel/installer/support"
 )
 
+const (
+	goravelRepo     = "https://github.com/goravel/goravel.git"
+	goravelLiteRepo = "https://github.com/goravel/goravel-lite.git"
+)
+
 var moduleNameRegexp = regexp.MustCompile(`^[a-zA-Z0-9./_~-]+$`)
 
+var projectNameRegexp = regexp.MustCompile(`^[\w.-]+(?:/[\w.-]+)*$`)
+
+var commitRefRegexp = regexp.MustCompile(`^[0-9a-f]{7,40}$`)
+
+// stdinIsTerminal Report whether questions can be asked on stdin, it can be replaced in tests.
+var stdinIsTerminal = func() bool {
+	return term.IsTerminal(int(os.Stdin.Fd()))
+}
+
+// projectOptions describes the project to generate.
+type projectOptions struct {
+	Conflict         string
+	Database         string
+	Docker           bool
+	DryRun           bool
+	Env              [][2]string
+	Events           *eventStream
+	Facades          []string
+	Git              *gitRepositoryOptions
+	GoModule         goModuleOptions
+	Merge            bool
+	Mirrors          []string
+	Module           string
+	ModuleRename     []moduleRenameRule
+	Name             string
+	NoHooks          bool
+	NoInteraction    bool
+	TrustedTemplates []string
+	Type             projectTypeEntry
+	Vars             map[string]string
+	Verify           *verifyOptions
+	Workspace        string
+}
+
 type NewCommand struct {
+	cleanup *projectCleanup
+	// interrupted reports whether the context of Handle has been cancelled, e.g. by Ctrl-C.
+	interrupted func() bool
 }
 
 func NewNewCommand() *NewCommand {
@@ -43,66 +89,367 @@ func (r *NewCommand) Extend() command.Extend {
 	return command.Extend{
 		ArgsUsage: " [--] <name>",
 		Flags: []command.Flag{
+			&command.StringFlag{
+				Name:  "catalog",
+				Usage: "The catalog of the project types, a YAML or JSON file: a local path or an HTTP(S) URL",
+			},
+			&command.StringFlag{
+				Name:  "conflict",
+				Usage: "How to resolve the existing files that differ from the template with --merge: keep, overwrite or abort. Asks for each file when omitted",
+			},
+			&command.StringFlag{
+				Name:  "database",
+				Usage: "Specify the database driver: postgres, mysql, sqlserver or sqlite",
+			},
+			&command.StringFlag{
+				Name:  "branch",
+				Usage: "The initial branch of the git repository, implies --git",
+			},
+			&command.BoolFlag{
+				Name:               "all-facades",
+				Usage:              "Install all facades of Goravel " + frameworksupport.Version + " in a lite project without asking",
+				DisableDefaultText: true,
+			},
 			&command.BoolFlag{
 				Name:               "dev",
 				Usage:              `Install the latest "development" release`,
 				DisableDefaultText: true,
 			},
+			&command.StringFlag{
+				Name:    "ref",
+				Aliases: []string{"version"},
+				Usage:   "Install the template at a specific tag, branch or commit, e.g. v1.16.0. Run the versions command to list the tags",
+			},
+			&command.BoolFlag{
+				Name:               "docker",
+				Usage:              "Generate a Dockerfile and a docker-compose.yml with the services of the project",
+				DisableDefaultText: true,
+			},
+			&command.StringFlag{
+				Name:  "facades",
+				Usage: "The comma-separated facades to install in a lite project without asking, e.g. cache,orm,queue. The names are checked against the facades of Goravel " + frameworksupport.Version + ", the framework version the installer is built with, a facade added in a later version can't be selected",
+			},
+			&command.BoolFlag{
+				Name:               "dry-run",
+				Usage:              "Print the plan of the project generation without writing anything to the target directory",
+				DisableDefaultText: true,
+			},
+			&command.StringFlag{
+				Name:  "format",
+				Usage: "The output format: text or json, json prints newline-delimited events instead of colored text",
+				Value: outputFormatText,
+			},
 			&command.BoolFlag{
 				Name:               "force",
 				Aliases:            []string{"f"},
 				Usage:              "Forces install even if the directory already exists",
 				DisableDefaultText: true,
 			},
+			&command.StringFlag{
+				Name:  "goprivate",
+				Usage: "The GOPRIVATE of the go commands run in the project, e.g. github.com/acme/*",
+			},
+			&command.StringFlag{
+				Name:  "goproxy",
+				Usage: "The GOPROXY of the go commands run in the project, e.g. https://goproxy.example.com,direct",
+			},
+			&command.BoolFlag{
+				Name:               "git",
+				Usage:              "Initialize a git repository with an initial commit",
+				DisableDefaultText: true,
+			},
+			&command.BoolFlag{
+				Name:               "merge",
+				Usage:              "Install into an existing directory, e.g. `goravel new . --merge`, keeping its files and .git",
+				DisableDefaultText: true,
+			},
 			&command.StringFlag{
 				Name:    "module",
 				Aliases: []string{"m"},
 				Usage:   "Specify the custom module name to replace the default 'goravel' module",
 			},
+			&command.BoolFlag{
+				Name:               "offline",
+				Usage:              "Create the project from the local template cache without network access",
+				DisableDefaultText: true,
+			},
+			&command.StringFlag{
+				Name:  "transport",
+				Usage: "How to fetch the template: git or archive. Defaults to git, or archive when git is not installed",
+			},
+			&command.StringFlag{
+				Name:  "mirror",
+				Usage: "The comma-separated mirrors to clone the template from: github, gitee or an HTTP(S) base URL, the next one is tried when a clone fails",
+			},
+			&command.StringFlag{
+				Name:  "remote",
+				Usage: "The origin remote of the git repository, implies --git",
+			},
+			&command.StringFlag{
+				Name:  "sha256",
+				Usage: "The expected SHA-256 checksum of the template archive",
+			},
+			&command.BoolFlag{
+				Name:               "skip-install",
+				Usage:              "Do not install the dependencies, print the commands to run later instead, e.g. without network access",
+				DisableDefaultText: true,
+			},
+			&command.StringFlag{
+				Name:  "type",
+				Usage: "Specify the project type: goravel or lite",
+			},
+			&command.BoolFlag{
+				Name:               "vendor",
+				Usage:              "Vendor the dependencies with go mod vendor",
+				DisableDefaultText: true,
+			},
+			&command.BoolFlag{
+				Name:               "no-hooks",
+				Usage:              "Do not run the pre and post hooks of the template",
+				DisableDefaultText: true,
+			},
+			&command.BoolFlag{
+				Name:               "no-interaction",
+				Aliases:            []string{"n", "yes", "y"},
+				Usage:              "Do not ask any interactive question, use the default value of every question",
+				DisableDefaultText: true,
+			},
+			&command.BoolFlag{
+				Name:               "verify",
+				Usage:              "Verify the project with go vet and go build once it's generated",
+				DisableDefaultText: true,
+			},
+			&command.BoolFlag{
+				Name:               "verify-tests",
+				Usage:              "Run go test as well when verifying the project, implies --verify",
+				DisableDefaultText: true,
+			},
+			&command.StringSliceFlag{
+				Name:  "var",
+				Usage: "Set a variable declared by the template without asking, e.g. --var app_port=8080. Can be repeated",
+			},
+			&command.StringSliceFlag{
+				Name:  "env",
+				Usage: "Set a value of .env without asking, e.g. --env APP_URL=https://blog.test. Can be repeated",
+			},
+			&command.BoolFlag{
+				Name:               "workspace",
+				Usage:              "Add the project to the go.work of a parent directory without asking",
+				DisableDefaultText: true,
+			},
+			&command.StringFlag{
+				Name:    "template",
+				Aliases: []string{"t"},
+				Usage:   "Create the project from a custom starter template: a git URL, a file:// repository, a local directory or a tarball",
+			},
 		},
 	}
 }
 
 // Handle Execute the console command.
-func (r *NewCommand) Handle(ctx console.Context) (err error) {
-	r.printWelcome(ctx)
-
-	name, err := r.getProjectName(ctx)
+func (r *NewCommand) Handle(ctx console.Context) error {
+	events, err := newEventStream(ctx.Option("format"))
 	if err != nil {
 		color.Errorln(err)
 		return nil
 	}
 
-	projectType, err := r.getProjectType(ctx)
+	r.cleanup = newProjectCleanup()
+	r.interrupted = func() bool {
+		return ctx.Err() != nil
+	}
+	processContext = ctx
+	defer func() {
+		processContext = context.Background()
+	}()
+
+	return events.Silence(func() error {
+		return r.handle(ctx, events)
+	})
+}
+
+// Shutdown Clean up the partially created project when the command is interrupted, e.g. by Ctrl-C. The child
+// processes have been stopped by the cancelled context of the command. An error is returned once the command has
+// been interrupted, so it exits with a non-zero code, the shutdown that follows a normal return has nothing to undo.
+func (r *NewCommand) Shutdown(ctx console.Context) error {
+	if r.cleanup.Run() || (r.interrupted != nil && r.interrupted()) {
+		return errors.New("the command has been interrupted, the project has not been created")
+	}
+
+	return nil
+}
+
+func (r *NewCommand) handle(ctx console.Context, events *eventStream) (err error) {
+	r.printWelcome(ctx)
+
+	config, err := loadInstallerConfig()
 	if err != nil {
-		return fmt.Errorf("failed to get project type: %s", err)
+		return events.Error(err)
 	}
+	ctx = newConfigContext(ctx, config, map[string]string{
+		"catalog":   "new.catalog",
+		"database":  "new.database",
+		"dev":       "new.dev",
+		"docker":    "new.docker",
+		"git":       "new.git",
+		"goprivate": "new.goprivate",
+		"goproxy":   "new.goproxy",
+		"offline":   "new.offline",
+		"type":      "new.type",
+	})
+
+	catalog := loadProjectCatalog(ctx.Option("catalog"))
+
+	noInteraction := ctx.OptionBool("no-interaction")
+	if !noInteraction && !stdinIsTerminal() {
+		if missing := r.getMissingInputs(ctx, config, catalog); len(missing) > 0 {
+			return events.Error(errors.New("stdin is not a terminal, unable to ask questions. Pass the missing values or use --no-interaction to take the defaults: " + strings.Join(missing, ", ")))
+		}
 
-	module, err := r.getModuleName(ctx)
+		noInteraction = true
+	}
+
+	name, err := r.getProjectName(ctx, noInteraction)
 	if err != nil {
-		color.Errorln(err)
-		return nil
+		return events.Error(err)
 	}
 
-	installLite := projectType == "lite"
-	if err = r.generateProject(ctx, name, module, installLite); err != nil {
-		color.Errorln(err)
+	projectType, err := r.getProjectType(ctx, catalog, noInteraction)
+	if err != nil {
+		return events.Error(fmt.Errorf("failed to get project type: %s", err))
+	}
+
+	module, err := r.getModuleName(ctx, getDefaultModuleName(config, name), noInteraction)
+	if err != nil {
+		return events.Error(err)
+	}
+
+	database, err := r.getDatabase(ctx, noInteraction)
+	if err != nil {
+		return events.Error(err)
+	}
+
+	facadeNames, err := r.getFacades(ctx, projectType)
+	if err != nil {
+		return events.Error(err)
+	}
+
+	git, err := r.getGitOptions(ctx)
+	if err != nil {
+		return events.Error(err)
+	}
+
+	vars, err := parseTemplateVars(ctx.OptionSlice("var"))
+	if err != nil {
+		return events.Error(err)
+	}
+
+	env, err := r.getProjectEnv(ctx, name, noInteraction)
+	if err != nil {
+		return events.Error(err)
+	}
+
+	workspace, err := r.getWorkspace(ctx, name, noInteraction)
+	if err != nil {
+		return events.Error(err)
+	}
+
+	merge := ctx.OptionBool("merge")
+	conflict, err := r.getMergeConflict(ctx, merge)
+	if err != nil {
+		return events.Error(err)
+	}
+
+	mirrors, err := getMirrors(ctx.Option("mirror"), config)
+	if err != nil {
+		return events.Error(err)
+	}
+
+	options := projectOptions{
+		Conflict: conflict,
+		Database: database,
+		Docker:   ctx.OptionBool("docker"),
+		DryRun:   ctx.OptionBool("dry-run"),
+		Env:      env,
+		Events:   events,
+		Facades:  facadeNames,
+		Git:      git,
+		GoModule: goModuleOptions{
+			Proxy:       ctx.Option("goproxy"),
+			Private:     ctx.Option("goprivate"),
+			SkipInstall: ctx.OptionBool("skip-install"),
+			Vendor:      ctx.OptionBool("vendor"),
+		},
+		Merge:            merge,
+		Mirrors:          mirrors,
+		Module:           module,
+		ModuleRename:     parseModuleRenameFiles(config.Get("new.module_rename_files")),
+		Name:             name,
+		NoHooks:          ctx.OptionBool("no-hooks"),
+		NoInteraction:    noInteraction,
+		TrustedTemplates: parseTrustedTemplates(config.Get("new.trusted_templates")),
+		Type:             projectType,
+		Vars:             vars,
+		Verify:           r.getVerifyOptions(ctx),
+		Workspace:        workspace,
+	}
+	if options.Verify != nil && options.GoModule.SkipInstall {
+		return events.Error(errors.New("the project can't be verified without its dependencies, remove the --skip-install option"))
+	}
+	if err = r.generateProject(ctx, options); err != nil {
+		// A failed verification is returned in the text format too, it's printed once by the console
+		if events == nil && errors.Is(err, errProjectVerification) {
+			return err
+		}
+
+		return events.Error(err)
+	}
+
+	events.Set("name", name)
+	events.Set("path", getAbsolutePath(name))
+	events.Set("module", module)
+	events.Set("type", projectType.Name)
+	events.Set("dry_run", options.DryRun)
+	if len(facadeNames) > 0 && !options.GoModule.SkipInstall {
+		events.Set("facades", facadeNames)
+	}
+	var commands []string
+	if options.GoModule.SkipInstall {
+		commands = skippedInstallCommands(options)
+		events.Set("skipped_commands", commands)
+	}
+	events.Summary()
+
+	if options.DryRun {
 		return nil
 	}
 
+	if len(facadeNames) > 0 && !options.GoModule.SkipInstall {
+		color.Successln("Installed facades: " + strings.Join(facadeNames, ", "))
+	}
 	color.Successln("Application ready in [<op=bold>" + name + "</>]. Build something amazing! 🚀🚀")
+	if len(commands) > 0 {
+		color.Warnln("Skipped installing the dependencies, run these commands in the project later:")
+		for _, command := range commands {
+			color.Printfln("  %s", command)
+		}
+	}
 	color.Successln("Are you new to Goravel? Please visit https://goravel.dev to get started.")
 
 	return
 }
 
-func (r *NewCommand) cloneGoravel(repo, path string, dev bool) error {
+func (r *NewCommand) cloneGoravel(repo, path, ref string, fallback bool) error {
+	if commitRefRegexp.MatchString(ref) {
+		return r.cloneGoravelCommit(repo, path, ref, fallback)
+	}
+
 	args := []string{"clone", "--depth=1", repo, path}
-	if dev {
-		args = slices.Insert(args, 2, "--branch=master")
+	if ref != "" {
+		args = slices.Insert(args, 2, "--branch="+ref)
 	}
 
-	res := facades.Process().Run("git", args...)
+	res := abortStalledClone(newProcess(), fallback).Run("git", args...)
 	if res.Failed() {
 		return fmt.Errorf("failed to clone goravel: %s", res.Error())
 	}
@@ -112,33 +459,441 @@ func (r *NewCommand) cloneGoravel(repo, path string, dev bool) error {
 	return nil
 }
 
-func (r *NewCommand) generateProject(ctx console.Context, name, module string, installLite bool) error {
-	path := getAbsolutePath(name)
+// cloneGoravelCommit Clone the full history then check out the commit, a shallow clone can't reach an arbitrary commit.
+func (r *NewCommand) cloneGoravelCommit(repo, path, commit string, fallback bool) error {
+	if res := abortStalledClone(newProcess(), fallback).Run("git", "clone", repo, path); res.Failed() {
+		return fmt.Errorf("failed to clone goravel: %s", res.Error())
+	}
+
+	if res := newProcess().Quietly().Path(path).Run("git", "checkout", commit); res.Failed() {
+		return fmt.Errorf("failed to check out %s: %s", commit, res.Error())
+	}
+
+	color.Successln("Cloned goravel at " + commit + " in " + path)
+
+	return nil
+}
+
+func (r *NewCommand) downloadGoravel(ctx context.Context, repo, path, ref, checksum string, mirrors []string) error {
+	url, err := archiveURL(repo, ref, mirrors)
+	if err != nil {
+		return err
+	}
+
+	archive, err := downloadArchive(ctx, url, checksum)
+	if err != nil {
+		return fmt.Errorf("failed to download goravel: %s", err)
+	}
+	defer func() {
+		_ = os.Remove(archive)
+	}()
+
+	if err := unpackArchive(archive, path); err != nil {
+		return fmt.Errorf("failed to extract goravel: %s", err)
+	}
+
+	color.Successln("Downloaded goravel in " + path)
+
+	return nil
+}
+
+func (r *NewCommand) fetchTemplate(ctx context.Context, source templateSource, path string, options templateFetchOptions) error {
+	switch source.Kind {
+	case templateKindDirectory:
+		return copyTemplateDirectory(source.Location, path)
+	case templateKindArchive:
+		return extractTemplateArchive(ctx, source.Location, options.Checksum, path)
+	}
+
+	ref := options.Ref
+	if options.Offline {
+		return restoreTemplateCache(source.Location, ref, path)
+	}
+
+	var err error
+	if options.Transport == transportArchive {
+		err = r.downloadGoravel(ctx, source.Location, path, ref, options.Checksum, options.Mirrors)
+	} else {
+		err = cloneFromMirrors(source.Location, options.Mirrors, path, func(repo string, fallback bool) error {
+			return r.cloneGoravel(repo, path, ref, fallback)
+		})
+	}
+	if err != nil {
+		if _, ok := readTemplateCache(source.Location, ref); !ok {
+			return err
+		}
+
+		color.Warnln(fmt.Sprintf("%s, falling back to the cached template", err))
+		if err := os.RemoveAll(path); err != nil {
+			return fmt.Errorf("failed to remove the directory: %s", err)
+		}
+
+		return restoreTemplateCache(source.Location, ref, path)
+	}
+
+	if err := saveTemplateCache(source.Location, ref, path); err != nil {
+		color.Warnln(fmt.Sprintf("Failed to cache the template: %s", err))
+	}
+
+	return nil
+}
+
+// generateProject Build the project in a temporary sibling directory, it only replaces the target directory once
+// every step succeeds, so a failure never leaves the user without their previous directory. A merge copies the project
+// over the target instead of replacing it. During a dry run the project is only built to print the plan, the target
+// directory is left untouched. What has been written is cleaned up when a step fails or the command is interrupted.
+func (r *NewCommand) generateProject(ctx console.Context, options projectOptions) (err error) {
+	defer func() {
+		if err != nil {
+			r.cleanup.Run()
+		} else {
+			r.cleanup.Commit()
+		}
+	}()
+
+	target := getAbsolutePath(options.Name)
+	if options.Merge && options.Git != nil && verifyIfDirectoryExists(filepath.Join(target, ".git")) {
+		return errors.New("the directory already has a git repository, remove the --git, --branch and --remote options to merge into it")
+	}
+
+	source, err := r.getTemplateSource(ctx, options.Type)
+	if err != nil {
+		return err
+	}
+
+	transport, err := resolveTransport(ctx.Option("transport"))
+	if err != nil {
+		return err
+	}
+
+	// The staging directory is a sibling of the target, so the project is moved with a rename. The parent directories
+	// of a nested project are created first, a dry run and a merge use the system temp directory instead: a merge copies
+	// the files, so the parent of the target doesn't have to be writable, e.g. with "goravel new . --merge".
+	stagingParent := filepath.Dir(target)
+	if options.DryRun {
+		if !verifyIfDirectoryExists(stagingParent) {
+			stagingParent = ""
+		}
+	} else {
+		created := firstMissingDirectory(stagingParent)
+		if err := os.MkdirAll(stagingParent, 0755); err != nil {
+			return fmt.Errorf("failed to create the directory: %s", err)
+		}
+		if created != "" {
+			r.cleanup.Add("Removed "+created, func() error {
+				return os.RemoveAll(created)
+			})
+		}
+	}
+	if options.Merge {
+		stagingParent = ""
+	}
+	stagingDir, err := os.MkdirTemp(stagingParent, "."+filepath.Base(target)+"-*")
+	if err != nil {
+		return fmt.Errorf("failed to create the staging directory: %s", err)
+	}
+	defer func() {
+		_ = os.RemoveAll(stagingDir)
+	}()
+	r.cleanup.Add("Removed the staging directory "+stagingDir, func() error {
+		return os.RemoveAll(stagingDir)
+	})
+
+	var plan *projectPlan
+	if options.DryRun {
+		plan = newProjectPlan()
+	}
+
+	path := filepath.Join(stagingDir, filepath.Base(target))
+	if err := r.buildInWorkspace(ctx, source, transport, path, target, options, plan); err != nil {
+		return err
+	}
+
+	var workspaceArgs []string
+	if options.Workspace != "" {
+		if workspaceArgs, err = goWorkUseArgs(options.Workspace, target); err != nil {
+			return err
+		}
+	}
+
+	var conflicts []string
+	if options.Merge {
+		if conflicts, err = findMergeConflicts(path, target); err != nil {
+			return err
+		}
+	}
+
+	if plan != nil {
+		switch {
+		case options.Merge:
+			plan.Add("Merge the project into " + target + ", its .git is kept")
+			strategy := options.Conflict
+			if strategy == "" && !options.NoInteraction {
+				strategy = "ask"
+			}
+			for _, conflict := range conflicts {
+				plan.Add("Resolve the conflicting file " + conflict + ": " + cmp.Or(strategy, mergeConflictAbort))
+			}
+		case verifyIfDirectoryExists(target):
+			plan.Add("Move the existing directory " + target + " to " + target + ".backup-<timestamp>")
+			plan.Add("Create " + target)
+		default:
+			plan.Add("Create " + target)
+		}
+		if options.Workspace != "" {
+			plan.Add("Run `" + formatCommand("go", workspaceArgs...) + "` in " + filepath.Dir(options.Workspace))
+		}
+		if options.Verify != nil {
+			if err := verifyProject(target, options, plan); err != nil {
+				return err
+			}
+		}
+		options.Events.Set("plan", plan.steps)
+
+		return plan.Print(path)
+	}
+
+	if options.Merge {
+		resolutions, err := r.resolveMergeConflicts(ctx, target, conflicts, options)
+		if err != nil {
+			return err
+		}
+		options.Events.Set("conflicts", resolutions)
+
+		if err := options.Events.Step("merge_project", "Merge the project into "+target, func() error {
+			return mergeProject(path, target, resolutions, r.cleanup)
+		}); err != nil {
+			return err
+		}
+	} else if err := options.Events.Step("move_project", "Move the project to "+target, func() error {
+		return r.moveProject(path, target)
+	}); err != nil {
+		return err
+	}
+
+	// The project is complete, a failure of the next steps keeps it
+	r.cleanup.Commit()
+
+	if options.Workspace != "" {
+		if err := options.Events.Step("use_workspace", "Add the project to the Go workspace "+options.Workspace, func() error {
+			return useGoWorkspace(options.Workspace, target)
+		}); err != nil {
+			return err
+		}
+	}
+
+	if options.Verify == nil {
+		return nil
+	}
+
+	return verifyProject(target, options, nil)
+}
+
+// buildInWorkspace Build the project with the workspace mode of go turned off when the target is in a workspace, the
+// staging directory is not one of its modules yet.
+func (r *NewCommand) buildInWorkspace(ctx console.Context, source templateSource, transport, path, target string, options projectOptions, plan *projectPlan) error {
+	if findGoWork(filepath.Dir(target)) != "" {
+		defer disableGoWorkspace()()
+	}
+
+	return r.buildProject(ctx, source, transport, path, options, plan)
+}
+
+func (r *NewCommand) buildProject(ctx console.Context, source templateSource, transport, path string, options projectOptions, plan *projectPlan) error {
+	events := options.Events
+	ref := cmp.Or(r.getTemplateRef(ctx), source.Ref)
+	events.Set("template", source.Location)
+	events.Set("ref", cmp.Or(ref, defaultTemplateRef))
 
-	// remove the directory if it already exists
-	if err := os.RemoveAll(path); err != nil {
-		return fmt.Errorf("failed to remove the directory: %s", err)
+	step := fmt.Sprintf("Fetch the %s template %s", source.Kind, source.Location)
+	if source.Kind == templateKindGit {
+		step += fmt.Sprintf(" at %s with %s", cmp.Or(ref, "the default branch"), transport)
+	}
+	if err := events.Step("fetch_template", step, func() error {
+		return r.fetchTemplate(ctx, source, path, templateFetchOptions{
+			Checksum:  ctx.Option("sha256"),
+			Mirrors:   options.Mirrors,
+			Offline:   ctx.OptionBool("offline"),
+			Ref:       ref,
+			Transport: transport,
+		})
+	}); err != nil {
+		return err
 	}
 
-	repo := "https://github.com/goravel/goravel.git"
-	if installLite {
-		repo = "https://github.com/goravel/goravel-lite.git"
+	plan.Add(step)
+	if err := plan.SnapshotTemplate(path); err != nil {
+		return err
 	}
 
-	if err := r.cloneGoravel(repo, path, ctx.OptionBool("dev")); err != nil {
+	manifest, err := readTemplateManifest(path)
+	if err != nil {
 		return err
 	}
 
-	if err := r.replaceModule(ctx, path, module); err != nil {
+	variables, err := resolveTemplateVariables(ctx, manifest.Variables, options.Vars, options.NoInteraction)
+	if err != nil {
 		return err
 	}
+	if len(manifest.Render) > 0 {
+		data := map[string]any{
+			"module":       options.Module,
+			"project_name": filepath.Base(getAbsolutePath(options.Name)),
+		}
+		maps.Copy(data, variables)
+		step := "Render the template variables in " + strings.Join(manifest.Render, ", ")
+		if err := events.Step("render_variables", step, func() error {
+			_, err := renderTemplateFiles(path, manifest.Render, data)
 
-	if err := r.initProject(path); err != nil {
+			return err
+		}); err != nil {
+			return err
+		}
+		plan.Add(step)
+	}
+
+	// The module is renamed once the variables are rendered, a Go file with a placeholder can't be parsed
+	if oldModule := getProjectModule(path); strings.Trim(options.Module, "/") != oldModule {
+		step := fmt.Sprintf("Update the module name from %q to %q", oldModule, options.Module)
+		if err := events.Step("update_module", step, func() error {
+			return r.replaceModule(ctx, events, path, options.Module, slices.Concat(moduleRenameRules, manifest.ModuleRename, options.ModuleRename))
+		}); err != nil {
+			return err
+		}
+		plan.Add(step)
+	}
+
+	runHooks := r.confirmHooks(ctx, source, manifest.Hooks, options, plan != nil)
+	hookVars := map[string]string{
+		"GORAVEL_MODULE":  options.Module,
+		"GORAVEL_PROJECT": filepath.Base(getAbsolutePath(options.Name)),
+	}
+	for name, value := range variables {
+		hookVars["GORAVEL_VAR_"+strings.ToUpper(name)] = fmt.Sprint(value)
+	}
+	if runHooks {
+		for _, hook := range manifest.Hooks.Pre {
+			if err := events.Step("pre_hook", "Run the pre hook "+hook, func() error {
+				return plan.Run(hook, func() error {
+					return runHook(path, "pre", hook, hookVars)
+				})
+			}); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := events.Step("init_project", "Install the dependencies, generate .env and the application key", func() error {
+		return r.initProject(path, options.GoModule, plan)
+	}); err != nil {
 		return err
 	}
 
-	if installLite {
-		if err := r.installFacades(path); err != nil {
+	var driver databaseDriver
+	if options.Database != "" {
+		var err error
+		if driver, err = getDatabaseDriver(options.Database); err != nil {
+			return err
+		}
+		step := "Configure the " + driver.Label + " database in .env"
+		if err := events.Step("configure_database", step, func() error {
+			return configureDatabase(path, driver)
+		}); err != nil {
+			return err
+		}
+		plan.Add(step)
+	}
+
+	if options.Type.InstallFacades && !options.GoModule.SkipInstall {
+		switch {
+		case len(options.Facades) > 0:
+			command := options.GoModule.Command(append([]string{"run", ".", "artisan", "package:install", "--default"}, options.Facades...)...)
+			if err := events.Step("install_facades", "Install the facades "+strings.Join(options.Facades, ", "), func() error {
+				return plan.Run(command, func() error {
+					return installSelectedFacades(path, options.Facades, options.GoModule)
+				})
+			}); err != nil {
+				return err
+			}
+		case options.NoInteraction:
+			events.Warning("Skipped installing facades, run \"./artisan package:install\" in the project or pass --facades to install them")
+		default:
+			if err := events.Step("install_facades", "Install the facades", func() error {
+				return plan.Run(options.GoModule.Command("run", ".", "artisan", "package:install"), func() error {
+					return r.installFacades(path, options.GoModule)
+				})
+			}); err != nil {
+				return err
+			}
+		}
+
+		if driver.Package != "" {
+			if err := events.Step("install_database_driver", "Install the "+driver.Label+" driver", func() error {
+				return plan.Run(options.GoModule.Command("run", ".", "artisan", "package:install", driver.Package), func() error {
+					return installDatabaseDriver(path, driver, options.GoModule)
+				})
+			}); err != nil {
+				return err
+			}
+		}
+	}
+
+	if runHooks {
+		for _, hook := range manifest.Hooks.Post {
+			if err := events.Step("post_hook", "Run the post hook "+hook, func() error {
+				return plan.Run(hook, func() error {
+					return runHook(path, "post", hook, hookVars)
+				})
+			}); err != nil {
+				return err
+			}
+		}
+	}
+
+	if options.GoModule.Vendor && !options.GoModule.SkipInstall {
+		if err := events.Step("vendor_dependencies", "Vendor the dependencies", func() error {
+			return vendorDependencies(path, options.GoModule, plan)
+		}); err != nil {
+			return err
+		}
+	}
+
+	if options.Docker {
+		step := "Generate Dockerfile, .dockerignore and docker-compose.yml"
+		if err := events.Step("generate_docker", step, func() error {
+			return generateDocker(path, options.Env)
+		}); err != nil {
+			return err
+		}
+		plan.Add(step)
+	}
+
+	// The values of the user are set last, so the database and the Docker credentials don't overwrite them
+	if len(options.Env) > 0 {
+		keys := make([]string, len(options.Env))
+		for i, value := range options.Env {
+			keys[i] = value[0]
+		}
+		step := "Set " + strings.Join(keys, ", ") + " in .env"
+		if err := events.Step("configure_env", step, func() error {
+			return configureEnv(path, options.Env)
+		}); err != nil {
+			return err
+		}
+		plan.Add(step)
+	}
+
+	if options.Git != nil {
+		message := fmt.Sprintf("Initial commit from Goravel Installer %s, template %s", support.Version, cmp.Or(ref, defaultTemplateRef))
+		var commands []string
+		for _, args := range gitRepositoryCommands(message, *options.Git) {
+			commands = append(commands, formatCommand("git", args...))
+		}
+		if err := events.Step("init_git", "Initialize a git repository with an initial commit", func() error {
+			return plan.Run(strings.Join(commands, " && "), func() error {
+				return initGitRepository(path, message, *options.Git)
+			})
+		}); err != nil {
 			return err
 		}
 	}
@@ -146,14 +901,193 @@ func (r *NewCommand) generateProject(ctx console.Context, name, module string, i
 	return nil
 }
 
-func (r *NewCommand) getModuleName(ctx console.Context) (string, error) {
+// moveProject Move the built project to the target, an existing target is moved to a timestamped backup first.
+func (r *NewCommand) moveProject(path, target string) error {
+	var backup string
+	if verifyIfDirectoryExists(target) {
+		backup = fmt.Sprintf("%s.backup-%s", target, time.Now().Format("20060102150405"))
+		if err := os.Rename(target, backup); err != nil {
+			return fmt.Errorf("failed to back up the existing directory: %s", err)
+		}
+		r.cleanup.Add("Restored "+target+" from "+backup, func() error {
+			return os.Rename(backup, target)
+		})
+	}
+
+	if err := os.Rename(path, target); err != nil {
+		if backup != "" {
+			_ = os.Rename(backup, target)
+		}
+
+		return fmt.Errorf("failed to move the project to %s: %s", target, err)
+	}
+
+	if backup != "" {
+		color.Warnln("Moved the existing directory to " + backup)
+	}
+
+	return nil
+}
+
+// getWorkspace Get the go.work of a parent directory to add the project to, it's empty when there is none or the user
+// declines.
+func (r *NewCommand) getWorkspace(ctx console.Context, name string, noInteraction bool) (string, error) {
+	use := ctx.OptionBool("workspace")
+	goWork := findGoWork(filepath.Dir(getAbsolutePath(name)))
+	if goWork == "" {
+		if use {
+			return "", errors.New("no go.work is found in the parent directories, remove the --workspace option")
+		}
+
+		return "", nil
+	}
+	if use {
+		return goWork, nil
+	}
+	if noInteraction {
+		color.Warnln("Found the Go workspace " + goWork + ", pass --workspace to add the project to it")
+		return "", nil
+	}
+
+	if ctx.Confirm("Do you want to add the project to the Go workspace "+goWork+"?", console.ConfirmOption{Default: true}) {
+		return goWork, nil
+	}
+
+	return "", nil
+}
+
+// getFacades Get the facades to install in a lite project without asking, it returns nil when they should be asked.
+func (r *NewCommand) getFacades(ctx console.Context, projectType projectTypeEntry) ([]string, error) {
+	value := ctx.Option("facades")
+	all := ctx.OptionBool("all-facades")
+	if value == "" && !all {
+		return nil, nil
+	}
+	if !projectType.InstallFacades {
+		return nil, fmt.Errorf("--facades and --all-facades are only supported by the project types that install facades, e.g. lite, the %s project includes its facades", projectType.Name)
+	}
+	if all {
+		return availableFacades(), nil
+	}
+
+	return parseFacades(value)
+}
+
+// getGitOptions Get the git repository to initialize, it returns nil when no repository is requested.
+// The git identity is verified upfront, so a missing one doesn't fail the command after the project is built.
+func (r *NewCommand) getGitOptions(ctx console.Context) (*gitRepositoryOptions, error) {
+	options := &gitRepositoryOptions{
+		Branch: ctx.Option("branch"),
+		Remote: ctx.Option("remote"),
+	}
+	if !ctx.OptionBool("git") && options.Branch == "" && options.Remote == "" {
+		return nil, nil
+	}
+
+	if err := checkGitIdentity(); err != nil {
+		return nil, err
+	}
+
+	return options, nil
+}
+
+// getMissingInputs Get the values that would have to be asked for, they are required when stdin is not a terminal.
+func (r *NewCommand) getMissingInputs(ctx console.Context, config *installerConfig, catalog projectCatalog) []string {
+	var missing []string
+	if ctx.Argument(0) == "" {
+		missing = append(missing, "<name>")
+	}
+	projectType := ctx.Option("type")
+	if projectType == "" {
+		missing = append(missing, "--type")
+	}
+	if ctx.Option("module") == "" && config.Get("new.module_prefix") == "" {
+		missing = append(missing, "--module")
+	}
+	if ctx.Option("database") == "" {
+		missing = append(missing, "--database")
+	}
+	if entry, err := catalog.Find(projectType); err == nil && entry.InstallFacades && ctx.Option("facades") == "" && !ctx.OptionBool("all-facades") {
+		missing = append(missing, "--facades")
+	}
+
+	return missing
+}
+
+// confirmHooks Report whether the hooks of the template should run, the user is asked to trust the templates that are
+// not trusted yet. The hooks are skipped when they can't be confirmed, a dry run records them without asking.
+func (r *NewCommand) confirmHooks(ctx console.Context, source templateSource, hooks templateHooks, options projectOptions, dryRun bool) bool {
+	if hooks.Empty() {
+		return false
+	}
+	if options.NoHooks {
+		color.Warnln("Skipped the hooks of the template")
+		return false
+	}
+	if isTrustedTemplate(source.Location, options.TrustedTemplates) {
+		return true
+	}
+	if options.NoInteraction {
+		color.Warnln("Skipped the hooks of the untrusted template " + source.Location + ", add it to the new.trusted_templates configuration to run them")
+		return false
+	}
+	if dryRun {
+		color.Warnln("The template " + source.Location + " is not trusted, you will be asked before its hooks run")
+		return true
+	}
+
+	color.Warnln("The template " + source.Location + " wants to run these commands in the project:")
+	for _, hook := range hooks.Pre {
+		color.Printfln("  pre:  %s", hook)
+	}
+	for _, hook := range hooks.Post {
+		color.Printfln("  post: %s", hook)
+	}
+
+	if !ctx.Confirm("Do you trust the template and want to run them?", console.ConfirmOption{
+		Description: "Add the template to the new.trusted_templates configuration to skip this question",
+	}) {
+		color.Warnln("Skipped the hooks of the template")
+		return false
+	}
+
+	return true
+}
+
+// getDatabase Get the database driver, an empty value keeps the database configured by the template.
+func (r *NewCommand) getDatabase(ctx console.Context, noInteraction bool) (string, error) {
+	if database := ctx.Option("database"); database != "" {
+		if _, err := getDatabaseDriver(database); err != nil {
+			return "", err
+		}
+
+		return database, nil
+	}
+
+	if noInteraction {
+		return "", nil
+	}
+
+	options := make([]console.Choice, len(databaseDrivers))
+	for i, driver := range databaseDrivers {
+		options[i] = console.Choice{Key: driver.Label, Value: driver.Name}
+	}
+
+	return ctx.Choice("Which database do you want to use?", options)
+}
+
+func (r *NewCommand) getModuleName(ctx console.Context, defaultModule string, noInteraction bool) (string, error) {
 	var err error
 	module := ctx.Option("module")
 
+	if module == "" && noInteraction {
+		module = defaultModule
+	}
+
 	if module == "" {
 		module, err = ctx.Ask("What is the module name?", console.AskOption{
 			Placeholder: "E.g. github.com/yourusername/yourproject",
-			Default:     support.DefaultModuleName,
+			Default:     defaultModule,
 			Prompt:      "> ",
 			Validate: func(value string) error {
 				if value == "" {
@@ -174,10 +1108,14 @@ func (r *NewCommand) getModuleName(ctx console.Context) (string, error) {
 	return module, nil
 }
 
-func (r *NewCommand) getProjectName(ctx console.Context) (string, error) {
+func (r *NewCommand) getProjectName(ctx console.Context, noInteraction bool) (string, error) {
 	var err error
 	name := ctx.Argument(0)
 
+	if name == "" && noInteraction {
+		return "", errors.New("the project name is required, pass it as the first argument")
+	}
+
 	if name == "" {
 		name, err = ctx.Ask("What is the name of your project?", console.AskOption{
 			Placeholder: "E.g example-app",
@@ -195,28 +1133,119 @@ func (r *NewCommand) getProjectName(ctx console.Context) (string, error) {
 		}
 	}
 
-	if !regexp.MustCompile(`^[\w.-]+$`).MatchString(name) {
-		return "", errors.New("the name only supports letters, numbers, dashes, underscores, and periods")
+	name = strings.ReplaceAll(name, `\`, "/")
+	// The current directory is only valid as a whole, e.g. goravel new . --merge
+	if name != "." && (!projectNameRegexp.MatchString(name) || slices.ContainsFunc(strings.Split(name, "/"), func(segment string) bool {
+		return segment == "." || segment == ".."
+	})) {
+		return "", errors.New("the name only supports letters, numbers, dashes, underscores, and periods, separate the directories of a nested project with slashes, e.g. services/billing")
 	}
 
 	force := ctx.OptionBool("force")
-	if !force && verifyIfDirectoryExists(getAbsolutePath(name)) {
-		return "", errors.New("the directory already exists. use the --force flag to overwrite")
+	if !verifyIfDirectoryExists(getAbsolutePath(name)) || ctx.OptionBool("merge") {
+		return name, nil
+	}
+	if !force {
+		return "", errors.New("the directory already exists. use the --force flag to overwrite it or the --merge flag to install into it")
+	}
+	if name == "." {
+		return "", errors.New("the current directory can't be overwritten, use the --merge flag to install into it")
 	}
 
 	return name, nil
 }
 
-func (r *NewCommand) getProjectType(ctx console.Context) (string, error) {
-	options := []console.Choice{
-		{Key: "Goravel      - Includes all facades", Value: "goravel"},
-		{Key: "Goravel Lite - Only includes essential facades", Value: "lite"},
+// getVerifyOptions Get how to verify the project once it's generated, it returns nil when no verification is requested.
+func (r *NewCommand) getVerifyOptions(ctx console.Context) *verifyOptions {
+	tests := ctx.OptionBool("verify-tests")
+	if !ctx.OptionBool("verify") && !tests {
+		return nil
+	}
+
+	return &verifyOptions{Tests: tests}
+}
+
+// getMergeConflict Get how to resolve the conflicting files of a merge, an empty value means asking for each file.
+func (r *NewCommand) getMergeConflict(ctx console.Context, merge bool) (string, error) {
+	conflict := ctx.Option("conflict")
+	if conflict == "" {
+		return "", nil
+	}
+	if !merge {
+		return "", errors.New("--conflict is only supported with --merge")
+	}
+	if !slices.Contains(mergeConflictStrategies, conflict) {
+		return "", fmt.Errorf("invalid conflict strategy %q, use one of: %s", conflict, strings.Join(mergeConflictStrategies, ", "))
+	}
+
+	return conflict, nil
+}
+
+// getProjectType Get the project type of the catalog, only the types the installer is recent enough to create are
+// offered.
+func (r *NewCommand) getProjectType(ctx console.Context, catalog projectCatalog, noInteraction bool) (projectTypeEntry, error) {
+	if name := ctx.Option("type"); name != "" {
+		return catalog.Find(name)
+	}
+
+	supported := catalog.Supported()
+	if len(supported) == 0 {
+		return projectTypeEntry{}, errors.New("every project type of the catalog requires a newer installer, run the upgrade command first")
+	}
+	if noInteraction {
+		return supported[0], nil
+	}
+
+	var width int
+	for _, entry := range supported {
+		width = max(width, len(entry.Name))
+	}
+	options := make([]console.Choice, len(supported))
+	for i, entry := range supported {
+		key := entry.Name
+		if entry.Description != "" {
+			key = fmt.Sprintf("%-*s - %s", width, entry.Name, entry.Description)
+		}
+		options[i] = console.Choice{Key: key, Value: entry.Name}
+	}
+
+	name, err := ctx.Choice("Which do you want to install?", options)
+	if err != nil {
+		return projectTypeEntry{}, err
+	}
+
+	return catalog.Find(name)
+}
+
+// getTemplateSource Get the template of the project, the repo of the project type is used when no custom template is
+// given.
+func (r *NewCommand) getTemplateSource(ctx console.Context, projectType projectTypeEntry) (templateSource, error) {
+	if template := ctx.Option("template"); template != "" {
+		return parseTemplateSource(template)
+	}
+
+	source, err := parseTemplateSource(projectType.Repo)
+	if err != nil {
+		return templateSource{}, fmt.Errorf("invalid repo of the project type %s: %s", projectType.Name, err)
+	}
+	source.Ref = projectType.Ref
+
+	return source, nil
+}
+
+// getTemplateRef Get the tag, branch or commit of the template to install, an empty ref means the default branch.
+func (r *NewCommand) getTemplateRef(ctx console.Context) string {
+	if ref := ctx.Option("ref"); ref != "" {
+		return ref
+	}
+	if ctx.OptionBool("dev") {
+		return "master"
 	}
 
-	return ctx.Choice("Which do you want to install?", options)
+	return ""
 }
 
-func (r *NewCommand) initProject(path string) error {
+func (r *NewCommand) initProject(path string, options goModuleOptions, plan *projectPlan) error {
 	if err := file.Remove(filepath.Join(path, ".git")); err != nil {
 		return fmt.Errorf("failed to remove .git: %s", err)
 	}
@@ -235,27 +1264,36 @@ func (r *NewCommand) initProject(path string) error {
 		}
 	}
 
-	if res := facades.Process().WithSpinner("Installing dependencies").Path(path).Run("go", "mod", "tidy"); res.Failed() {
-		return fmt.Errorf("failed to install dependencies: %s", res.Error())
-	}
+	plan.Add("Remove .git, .github and main_test.go")
 
-	color.Successln("Installed dependencies")
+	if options.SkipInstall {
+		plan.Add("Skip installing the dependencies")
+	} else if err := installDependencies(path, options, plan); err != nil {
+		return err
+	}
 
 	if err := file.Copy(filepath.Join(path, ".env.example"), filepath.Join(path, ".env")); err != nil {
 		return fmt.Errorf("failed to generate .env file: %s", err)
 	}
 
 	color.Successln("Generated .env file")
+	plan.Add("Generate .env from .env.example")
 
-	if res := facades.Process().WithSpinner("Generating application key").Path(path).Run("go", "run", ".", "artisan", "key:generate"); res.Failed() {
-		return fmt.Errorf("failed to generate app key: %s", res.Error())
+	if options.SkipInstall {
+		return nil
 	}
 
-	return nil
+	return plan.Run(options.Command("run", ".", "artisan", "key:generate"), func() error {
+		if res := options.Process(path, "Generating application key").Run("go", "run", ".", "artisan", "key:generate"); res.Failed() {
+			return fmt.Errorf("failed to generate app key: %s", res.Error())
+		}
+
+		return nil
+	})
 }
 
-func (r *NewCommand) installFacades(path string) error {
-	if res := facades.Process().TTY().Path(path).Run("go", "run", ".", "artisan", "package:install"); res.Failed() {
+func (r *NewCommand) installFacades(path string, options goModuleOptions) error {
+	if res := options.WithEnv(newProcess().TTY().Path(path)).Run("go", "run", ".", "artisan", "package:install"); res.Failed() {
 		return fmt.Errorf("failed to install facades: %s", res.Error())
 	}
 
@@ -267,66 +1305,50 @@ func (r *NewCommand) printWelcome(ctx console.Context) {
 	ctx.NewLine()
 }
 
-func (r *NewCommand) replaceModule(ctx console.Context, path, module string) error {
-	if module == support.DefaultModuleName {
+func (r *NewCommand) replaceModule(ctx console.Context, events *eventStream, path, module string, rules []moduleRenameRule) error {
+	module = strings.Trim(module, "/")
+	oldModule := getProjectModule(path)
+	if module == oldModule {
 		return nil
 	}
 
+	var changed []string
+	renamer := newModuleRenamer(oldModule, module, rules)
 	if err := ctx.Spinner("Updating module name to \""+module+"\"", console.SpinnerOption{
 		Action: func() error {
-			module = strings.Trim(module, "/")
-			reModule := regexp.MustCompile(`^module\s+goravel\b`)
-			reImport := regexp.MustCompile(`"goravel/([^"]+)"`)
-
-			return filepath.Walk(path, func(filePath string, info os.FileInfo, err error) error {
-				if err != nil || info.IsDir() || (!strings.HasSuffix(filePath, ".go") && !strings.HasSuffix(filePath, ".mod")) {
-					return err
-				}
-
-				fileContent, err := os.Open(filePath)
-				if err != nil {
-					return fmt.Errorf("error opening %s: %w", filePath, err)
-				}
-				defer errors.Ignore(fileContent.Close)
-
-				var newContent strings.Builder
-				var modified bool
-				scanner := bufio.NewScanner(fileContent)
-				for scanner.Scan() {
-					line := scanner.Text()
-					var newLine string
-
-					if strings.HasSuffix(filePath, ".mod") {
-						newLine = reModule.ReplaceAllString(line, "module "+module)
-					} else {
-						newLine = reImport.ReplaceAllString(line, `"`+module+`/$1"`)
-					}
-
-					if newLine != line {
-						modified = true
-					}
-					newContent.WriteString(newLine + "\n")
-				}
+			var err error
+			changed, err = renamer.Rename(path)
 
-				if err := scanner.Err(); err != nil {
-					return fmt.Errorf("error reading %s: %w", filePath, err)
-				}
-
-				if modified {
-					return os.WriteFile(filePath, []byte(newContent.String()), 0644)
-				}
-				return nil
-			})
+			return err
 		},
 	}); err != nil {
 		return fmt.Errorf("failed to update module name: %s", err)
 	}
 
-	color.Successln("Updated Module name")
+	color.Successln(fmt.Sprintf("Updated Module name in %d files", len(changed)))
+	for _, changedFile := range changed {
+		color.Gray().Println("  " + changedFile)
+	}
+	for _, skipped := range renamer.Skipped() {
+		events.Warning(fmt.Sprintf("Skipped updating the module name in %s, update its imports manually", skipped))
+	}
 
 	return nil
 }
 
+// getDefaultModuleName Get the default module name, it's built from the configured module prefix when there is one.
+// The current directory is named after its base name.
+func getDefaultModuleName(config *installerConfig, name string) string {
+	if name == "." {
+		name = filepath.Base(getAbsolutePath(name))
+	}
+	if prefix := strings.Trim(config.Get("new.module_prefix"), "/"); prefix != "" {
+		return prefix + "/" + name
+	}
+
+	return support.DefaultModuleName
+}
+
 func checkModuleName(module string) bool {
 	return moduleNameRegexp.MatchString(module)
 }
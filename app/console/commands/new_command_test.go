@@ -1,6 +1,8 @@
 package commands
 
 import (
+	"context"
+	"io"
 	"os"
 	"path/filepath"
 	"testing"
@@ -9,6 +11,7 @@ import (
 	"github.com/goravel/framework/contracts/process"
 	mocksconsole "github.com/goravel/framework/mocks/console"
 	mocksprocess "github.com/goravel/framework/mocks/process"
+	"github.com/goravel/framework/support/color"
 	"github.com/goravel/framework/support/env"
 	frameworkmock "github.com/goravel/framework/testing/mock"
 	"github.com/stretchr/testify/assert"
@@ -79,7 +82,7 @@ func TestGetProjectName(t *testing.T) {
 		mockContext.EXPECT().Argument(0).Return("my-project").Once()
 		mockContext.EXPECT().OptionBool("force").Return(false).Once()
 
-		name, err := newCommand.getProjectName(mockContext)
+		name, err := newCommand.getProjectName(mockContext, false)
 		assert.Nil(t, err)
 		assert.Equal(t, "my-project", name)
 	})
@@ -89,7 +92,7 @@ func TestGetProjectName(t *testing.T) {
 		mockContext.EXPECT().Argument(0).Return("my_project").Once()
 		mockContext.EXPECT().OptionBool("force").Return(false).Once()
 
-		name, err := newCommand.getProjectName(mockContext)
+		name, err := newCommand.getProjectName(mockContext, false)
 		assert.Nil(t, err)
 		assert.Equal(t, "my_project", name)
 	})
@@ -99,7 +102,7 @@ func TestGetProjectName(t *testing.T) {
 		mockContext.EXPECT().Argument(0).Return("my.project").Once()
 		mockContext.EXPECT().OptionBool("force").Return(false).Once()
 
-		name, err := newCommand.getProjectName(mockContext)
+		name, err := newCommand.getProjectName(mockContext, false)
 		assert.Nil(t, err)
 		assert.Equal(t, "my.project", name)
 	})
@@ -109,7 +112,7 @@ func TestGetProjectName(t *testing.T) {
 		mockContext.EXPECT().Argument(0).Return("MyProject_123-v2.0").Once()
 		mockContext.EXPECT().OptionBool("force").Return(false).Once()
 
-		name, err := newCommand.getProjectName(mockContext)
+		name, err := newCommand.getProjectName(mockContext, false)
 		assert.Nil(t, err)
 		assert.Equal(t, "MyProject_123-v2.0", name)
 	})
@@ -118,9 +121,9 @@ func TestGetProjectName(t *testing.T) {
 		mockContext := mocksconsole.NewContext(t)
 		mockContext.EXPECT().Argument(0).Return("my@project").Once()
 
-		name, err := newCommand.getProjectName(mockContext)
+		name, err := newCommand.getProjectName(mockContext, false)
 		assert.NotNil(t, err)
-		assert.Equal(t, "the name only supports letters, numbers, dashes, underscores, and periods", err.Error())
+		assert.Equal(t, "the name only supports letters, numbers, dashes, underscores, and periods, separate the directories of a nested project with slashes, e.g. services/billing", err.Error())
 		assert.Equal(t, "", name)
 	})
 
@@ -128,22 +131,34 @@ func TestGetProjectName(t *testing.T) {
 		mockContext := mocksconsole.NewContext(t)
 		mockContext.EXPECT().Argument(0).Return("my project").Once()
 
-		name, err := newCommand.getProjectName(mockContext)
+		name, err := newCommand.getProjectName(mockContext, false)
 		assert.NotNil(t, err)
-		assert.Equal(t, "the name only supports letters, numbers, dashes, underscores, and periods", err.Error())
+		assert.Equal(t, "the name only supports letters, numbers, dashes, underscores, and periods, separate the directories of a nested project with slashes, e.g. services/billing", err.Error())
 		assert.Equal(t, "", name)
 	})
 
-	t.Run("invalid project name with slash", func(t *testing.T) {
+	t.Run("nested project name", func(t *testing.T) {
+		t.Chdir(t.TempDir())
 		mockContext := mocksconsole.NewContext(t)
-		mockContext.EXPECT().Argument(0).Return("my/project").Once()
+		mockContext.EXPECT().Argument(0).Return(`services\billing`).Once()
+		mockContext.EXPECT().OptionBool("force").Return(false).Once()
 
-		name, err := newCommand.getProjectName(mockContext)
-		assert.NotNil(t, err)
-		assert.Equal(t, "the name only supports letters, numbers, dashes, underscores, and periods", err.Error())
-		assert.Equal(t, "", name)
+		name, err := newCommand.getProjectName(mockContext, false)
+		assert.Nil(t, err)
+		assert.Equal(t, "services/billing", name)
 	})
 
+	for _, invalid := range []string{"../project", "/srv/project", "services//billing", "services/./billing", "services/"} {
+		t.Run("invalid nested project name "+invalid, func(t *testing.T) {
+			mockContext := mocksconsole.NewContext(t)
+			mockContext.EXPECT().Argument(0).Return(invalid).Once()
+
+			name, err := newCommand.getProjectName(mockContext, false)
+			assert.EqualError(t, err, "the name only supports letters, numbers, dashes, underscores, and periods, separate the directories of a nested project with slashes, e.g. services/billing")
+			assert.Equal(t, "", name)
+		})
+	}
+
 	t.Run("directory already exists without force flag", func(t *testing.T) {
 		tmpDir, err := os.MkdirTemp("", "test-project-exists")
 		assert.Nil(t, err)
@@ -166,10 +181,11 @@ func TestGetProjectName(t *testing.T) {
 		mockContext := mocksconsole.NewContext(t)
 		mockContext.EXPECT().Argument(0).Return(projectName).Once()
 		mockContext.EXPECT().OptionBool("force").Return(false).Once()
+		mockContext.EXPECT().OptionBool("merge").Return(false).Once()
 
-		name, err := newCommand.getProjectName(mockContext)
+		name, err := newCommand.getProjectName(mockContext, false)
 		assert.NotNil(t, err)
-		assert.Equal(t, "the directory already exists. use the --force flag to overwrite", err.Error())
+		assert.Equal(t, "the directory already exists. use the --force flag to overwrite it or the --merge flag to install into it", err.Error())
 		assert.Equal(t, "", name)
 	})
 
@@ -195,11 +211,304 @@ func TestGetProjectName(t *testing.T) {
 		mockContext := mocksconsole.NewContext(t)
 		mockContext.EXPECT().Argument(0).Return(projectName).Once()
 		mockContext.EXPECT().OptionBool("force").Return(true).Once()
+		mockContext.EXPECT().OptionBool("merge").Return(false).Once()
 
-		name, err := newCommand.getProjectName(mockContext)
+		name, err := newCommand.getProjectName(mockContext, false)
 		assert.Nil(t, err)
 		assert.Equal(t, projectName, name)
 	})
+
+	t.Run("directory already exists with merge flag", func(t *testing.T) {
+		workDir := t.TempDir()
+		t.Chdir(workDir)
+		writeFile(t, filepath.Join(workDir, "blog", "README.md"), "# Blog")
+
+		mockContext := mocksconsole.NewContext(t)
+		mockContext.EXPECT().Argument(0).Return("blog").Once()
+		mockContext.EXPECT().OptionBool("force").Return(false).Once()
+		mockContext.EXPECT().OptionBool("merge").Return(true).Once()
+
+		name, err := newCommand.getProjectName(mockContext, false)
+		assert.Nil(t, err)
+		assert.Equal(t, "blog", name)
+	})
+
+	t.Run("current directory with merge flag", func(t *testing.T) {
+		t.Chdir(t.TempDir())
+
+		mockContext := mocksconsole.NewContext(t)
+		mockContext.EXPECT().Argument(0).Return(".").Once()
+		mockContext.EXPECT().OptionBool("force").Return(false).Once()
+		mockContext.EXPECT().OptionBool("merge").Return(true).Once()
+
+		name, err := newCommand.getProjectName(mockContext, false)
+		assert.Nil(t, err)
+		assert.Equal(t, ".", name)
+	})
+
+	t.Run("current directory with force flag", func(t *testing.T) {
+		t.Chdir(t.TempDir())
+
+		mockContext := mocksconsole.NewContext(t)
+		mockContext.EXPECT().Argument(0).Return(".").Once()
+		mockContext.EXPECT().OptionBool("force").Return(true).Once()
+		mockContext.EXPECT().OptionBool("merge").Return(false).Once()
+
+		name, err := newCommand.getProjectName(mockContext, false)
+		assert.EqualError(t, err, "the current directory can't be overwritten, use the --merge flag to install into it")
+		assert.Equal(t, "", name)
+	})
+
+	t.Run("missing project name without interaction", func(t *testing.T) {
+		mockContext := mocksconsole.NewContext(t)
+		mockContext.EXPECT().Argument(0).Return("").Once()
+
+		name, err := newCommand.getProjectName(mockContext, true)
+		assert.NotNil(t, err)
+		assert.Equal(t, "the project name is required, pass it as the first argument", err.Error())
+		assert.Equal(t, "", name)
+	})
+}
+
+func TestGetProjectType(t *testing.T) {
+	newCommand := &NewCommand{}
+
+	t.Run("type provided", func(t *testing.T) {
+		mockContext := mocksconsole.NewContext(t)
+		mockContext.EXPECT().Option("type").Return("lite").Once()
+
+		projectType, err := newCommand.getProjectType(mockContext, builtinProjectCatalog, false)
+		assert.Nil(t, err)
+		assert.Equal(t, builtinProjectCatalog.Types[1], projectType)
+	})
+
+	t.Run("invalid type provided", func(t *testing.T) {
+		mockContext := mocksconsole.NewContext(t)
+		mockContext.EXPECT().Option("type").Return("full").Once()
+
+		projectType, err := newCommand.getProjectType(mockContext, builtinProjectCatalog, false)
+		assert.NotNil(t, err)
+		assert.Equal(t, `invalid project type "full", use one of: goravel, lite`, err.Error())
+		assert.Equal(t, projectTypeEntry{}, projectType)
+	})
+
+	t.Run("default type without interaction", func(t *testing.T) {
+		mockContext := mocksconsole.NewContext(t)
+		mockContext.EXPECT().Option("type").Return("").Once()
+
+		projectType, err := newCommand.getProjectType(mockContext, builtinProjectCatalog, true)
+		assert.Nil(t, err)
+		assert.Equal(t, "goravel", projectType.Name)
+	})
+
+	t.Run("ask for type", func(t *testing.T) {
+		mockContext := mocksconsole.NewContext(t)
+		mockContext.EXPECT().Option("type").Return("").Once()
+		mockContext.EXPECT().Choice("Which do you want to install?", []console.Choice{
+			{Key: "goravel - Includes all facades", Value: "goravel"},
+			{Key: "lite    - Only includes essential facades", Value: "lite"},
+		}).Return("lite", nil).Once()
+
+		projectType, err := newCommand.getProjectType(mockContext, builtinProjectCatalog, false)
+		assert.Nil(t, err)
+		assert.Equal(t, "lite", projectType.Name)
+	})
+
+	t.Run("only offer the supported types", func(t *testing.T) {
+		catalog := projectCatalog{Types: []projectTypeEntry{
+			{Name: "next", Repo: goravelRepo, MinInstallerVersion: "v99.0.0"},
+			{Name: "api", Repo: goravelRepo},
+		}}
+		mockContext := mocksconsole.NewContext(t)
+		mockContext.EXPECT().Option("type").Return("").Once()
+
+		projectType, err := newCommand.getProjectType(mockContext, catalog, true)
+		assert.Nil(t, err)
+		assert.Equal(t, "api", projectType.Name)
+
+		mockContext.EXPECT().Option("type").Return("next").Once()
+
+		_, err = newCommand.getProjectType(mockContext, catalog, true)
+		assert.EqualError(t, err, `the project type "next" requires the installer v99.0.0 or newer, run the upgrade command first`)
+	})
+}
+
+func TestGetModuleName(t *testing.T) {
+	newCommand := &NewCommand{}
+
+	t.Run("default module without interaction", func(t *testing.T) {
+		mockContext := mocksconsole.NewContext(t)
+		mockContext.EXPECT().Option("module").Return("").Once()
+
+		module, err := newCommand.getModuleName(mockContext, "goravel", true)
+		assert.Nil(t, err)
+		assert.Equal(t, "goravel", module)
+	})
+
+	t.Run("default module from the configured prefix", func(t *testing.T) {
+		config := &installerConfig{values: map[string]string{"new.module_prefix": "github.com/acme/"}}
+		mockContext := mocksconsole.NewContext(t)
+		mockContext.EXPECT().Option("module").Return("").Once()
+
+		module, err := newCommand.getModuleName(mockContext, getDefaultModuleName(config, "blog"), true)
+		assert.Nil(t, err)
+		assert.Equal(t, "github.com/acme/blog", module)
+	})
+
+	t.Run("invalid module provided", func(t *testing.T) {
+		mockContext := mocksconsole.NewContext(t)
+		mockContext.EXPECT().Option("module").Return("invalid module").Once()
+
+		module, err := newCommand.getModuleName(mockContext, "goravel", true)
+		assert.NotNil(t, err)
+		assert.Equal(t, "", module)
+	})
+}
+
+func TestGetDatabase(t *testing.T) {
+	newCommand := &NewCommand{}
+
+	t.Run("database provided", func(t *testing.T) {
+		mockContext := mocksconsole.NewContext(t)
+		mockContext.EXPECT().Option("database").Return("mysql").Once()
+
+		database, err := newCommand.getDatabase(mockContext, false)
+		assert.Nil(t, err)
+		assert.Equal(t, "mysql", database)
+	})
+
+	t.Run("invalid database provided", func(t *testing.T) {
+		mockContext := mocksconsole.NewContext(t)
+		mockContext.EXPECT().Option("database").Return("mongodb").Once()
+
+		database, err := newCommand.getDatabase(mockContext, false)
+		assert.EqualError(t, err, `invalid database "mongodb", use one of: postgres, mysql, sqlserver, sqlite`)
+		assert.Equal(t, "", database)
+	})
+
+	t.Run("keep the template database without interaction", func(t *testing.T) {
+		mockContext := mocksconsole.NewContext(t)
+		mockContext.EXPECT().Option("database").Return("").Once()
+
+		database, err := newCommand.getDatabase(mockContext, true)
+		assert.Nil(t, err)
+		assert.Equal(t, "", database)
+	})
+
+	t.Run("ask for database", func(t *testing.T) {
+		mockContext := mocksconsole.NewContext(t)
+		mockContext.EXPECT().Option("database").Return("").Once()
+		mockContext.EXPECT().Choice("Which database do you want to use?", mock.Anything).Return("postgres", nil).Once()
+
+		database, err := newCommand.getDatabase(mockContext, false)
+		assert.Nil(t, err)
+		assert.Equal(t, "postgres", database)
+	})
+}
+
+func TestGetFacades(t *testing.T) {
+	newCommand := &NewCommand{}
+
+	t.Run("ask for facades", func(t *testing.T) {
+		mockContext := mocksconsole.NewContext(t)
+		mockContext.EXPECT().Option("facades").Return("").Once()
+		mockContext.EXPECT().OptionBool("all-facades").Return(false).Once()
+
+		facadeNames, err := newCommand.getFacades(mockContext, builtinProjectCatalog.Types[1])
+		assert.Nil(t, err)
+		assert.Nil(t, facadeNames)
+	})
+
+	t.Run("facades provided", func(t *testing.T) {
+		mockContext := mocksconsole.NewContext(t)
+		mockContext.EXPECT().Option("facades").Return("queue, orm,route").Once()
+		mockContext.EXPECT().OptionBool("all-facades").Return(false).Once()
+
+		facadeNames, err := newCommand.getFacades(mockContext, builtinProjectCatalog.Types[1])
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"Route", "Orm", "Queue"}, facadeNames)
+	})
+
+	t.Run("all facades", func(t *testing.T) {
+		mockContext := mocksconsole.NewContext(t)
+		mockContext.EXPECT().Option("facades").Return("").Once()
+		mockContext.EXPECT().OptionBool("all-facades").Return(true).Once()
+
+		facadeNames, err := newCommand.getFacades(mockContext, builtinProjectCatalog.Types[1])
+		assert.Nil(t, err)
+		assert.Equal(t, availableFacades(), facadeNames)
+	})
+
+	t.Run("goravel project", func(t *testing.T) {
+		mockContext := mocksconsole.NewContext(t)
+		mockContext.EXPECT().Option("facades").Return("cache").Once()
+		mockContext.EXPECT().OptionBool("all-facades").Return(false).Once()
+
+		facadeNames, err := newCommand.getFacades(mockContext, builtinProjectCatalog.Types[0])
+		assert.ErrorContains(t, err, "only supported by the project types that install facades")
+		assert.Nil(t, facadeNames)
+	})
+}
+
+func TestConfirmHooks(t *testing.T) {
+	newCommand := &NewCommand{}
+	source := templateSource{Kind: templateKindGit, Location: "https://github.com/acme/skeleton.git"}
+	hooks := templateHooks{Post: []string{"rm -rf app/examples"}}
+
+	t.Run("no hooks", func(t *testing.T) {
+		assert.False(t, newCommand.confirmHooks(mocksconsole.NewContext(t), source, templateHooks{}, projectOptions{}, false))
+	})
+
+	t.Run("hooks are disabled", func(t *testing.T) {
+		color.CaptureOutput(func(w io.Writer) {
+			assert.False(t, newCommand.confirmHooks(mocksconsole.NewContext(t), source, hooks, projectOptions{NoHooks: true}, false))
+		})
+	})
+
+	t.Run("trusted template", func(t *testing.T) {
+		options := projectOptions{TrustedTemplates: []string{"https://github.com/acme/skeleton"}}
+		assert.True(t, newCommand.confirmHooks(mocksconsole.NewContext(t), source, hooks, options, false))
+	})
+
+	t.Run("untrusted template without interaction", func(t *testing.T) {
+		captureOutput := color.CaptureOutput(func(w io.Writer) {
+			assert.False(t, newCommand.confirmHooks(mocksconsole.NewContext(t), source, hooks, projectOptions{NoInteraction: true}, false))
+		})
+		assert.Contains(t, captureOutput, "Skipped the hooks of the untrusted template")
+	})
+
+	t.Run("untrusted template without interaction during a dry run", func(t *testing.T) {
+		captureOutput := color.CaptureOutput(func(w io.Writer) {
+			assert.False(t, newCommand.confirmHooks(mocksconsole.NewContext(t), source, hooks, projectOptions{NoInteraction: true}, true))
+		})
+		assert.Contains(t, captureOutput, "Skipped the hooks of the untrusted template")
+	})
+
+	t.Run("untrusted template during a dry run", func(t *testing.T) {
+		captureOutput := color.CaptureOutput(func(w io.Writer) {
+			assert.True(t, newCommand.confirmHooks(mocksconsole.NewContext(t), source, hooks, projectOptions{}, true))
+		})
+		assert.Contains(t, captureOutput, "you will be asked before its hooks run")
+	})
+
+	t.Run("untrusted template is confirmed", func(t *testing.T) {
+		mockContext := mocksconsole.NewContext(t)
+		mockContext.EXPECT().Confirm("Do you trust the template and want to run them?", mock.Anything).Return(true).Once()
+
+		captureOutput := color.CaptureOutput(func(w io.Writer) {
+			assert.True(t, newCommand.confirmHooks(mockContext, source, hooks, projectOptions{}, false))
+		})
+		assert.Contains(t, captureOutput, "post: rm -rf app/examples")
+	})
+
+	t.Run("untrusted template is declined", func(t *testing.T) {
+		mockContext := mocksconsole.NewContext(t)
+		mockContext.EXPECT().Confirm("Do you trust the template and want to run them?", mock.Anything).Return(false).Once()
+
+		color.CaptureOutput(func(w io.Writer) {
+			assert.False(t, newCommand.confirmHooks(mockContext, source, hooks, projectOptions{}, false))
+		})
+	})
 }
 
 func TestHandle(t *testing.T) {
@@ -207,6 +516,7 @@ func TestHandle(t *testing.T) {
 
 	mockFactory := frameworkmock.Factory()
 	mockProcess := mockFactory.Process()
+	mockProcess.EXPECT().WithContext(mock.Anything).Return(mockProcess).Maybe()
 
 	tmpDir, err := os.MkdirTemp("", "test-handle-happy")
 	assert.Nil(t, err)
@@ -222,6 +532,8 @@ func TestHandle(t *testing.T) {
 		_ = os.Chdir(currentDir)
 	}()
 
+	isolateUserDirs(t)
+
 	projectName := "test-project"
 	moduleName := "github.com/test/project"
 	projectPath := filepath.Join(tmpDir, projectName)
@@ -229,14 +541,21 @@ func TestHandle(t *testing.T) {
 	// Create mock context
 	mockContext := mocksconsole.NewContext(t)
 
+	mockContext.EXPECT().Option("format").Return("").Once()
 	// Mock printWelcome (NewLine call)
 	mockContext.EXPECT().NewLine().Once()
+	mockContext.EXPECT().Option("catalog").Return("").Once()
+
+	// Mock the interaction mode
+	setStdinIsTerminal(t, true)
+	mockContext.EXPECT().OptionBool("no-interaction").Return(false).Once()
 
 	// Mock getProjectName
 	mockContext.EXPECT().Argument(0).Return(projectName).Once()
 	mockContext.EXPECT().OptionBool("force").Return(false).Once()
 
 	// Mock getProjectType
+	mockContext.EXPECT().Option("type").Return("").Once()
 	mockContext.EXPECT().Choice("Which do you want to install?", mock.MatchedBy(func(choices []console.Choice) bool {
 		return len(choices) == 2
 	})).Return("goravel", nil).Once()
@@ -244,11 +563,56 @@ func TestHandle(t *testing.T) {
 	// Mock getModuleName
 	mockContext.EXPECT().Option("module").Return(moduleName).Once()
 
-	// Mock generateProject - cloneGoravel
-	mockContext.EXPECT().OptionBool("dev").Return(false).Once()
+	// Mock getDatabase
+	mockContext.EXPECT().Option("database").Return("").Once()
+	mockContext.EXPECT().Choice("Which database do you want to use?", mock.MatchedBy(func(choices []console.Choice) bool {
+		return len(choices) == 4
+	})).Return("sqlite", nil).Once()
+
+	// Mock getFacades
+	mockContext.EXPECT().Option("facades").Return("").Once()
+	mockContext.EXPECT().OptionBool("all-facades").Return(false).Once()
+
+	// Mock the docker and dry-run options
+	mockContext.EXPECT().OptionBool("docker").Return(false).Once()
+	mockContext.EXPECT().OptionBool("dry-run").Return(false).Once()
+	mockContext.EXPECT().OptionBool("no-hooks").Return(false).Once()
+	mockContext.EXPECT().OptionSlice("var").Return(nil).Once()
+
+	// Mock getProjectEnv
+	mockContext.EXPECT().OptionSlice("env").Return([]string{"APP_URL=https://blog.test"}).Once()
+	mockContext.EXPECT().Ask("What is the application name?", mock.Anything).Return("Test Project", nil).Once()
+	mockContext.EXPECT().Ask("Which port does the application listen on?", mock.Anything).Return("8080", nil).Once()
+	mockContext.EXPECT().Ask("What is the timezone of the application?", mock.Anything).Return("Europe/Paris", nil).Once()
+	mockContext.EXPECT().Ask("What is the locale of the application?", mock.Anything).Return("en", nil).Once()
+
+	// Mock the go module options
+	mockContext.EXPECT().Option("goproxy").Return("").Once()
+	mockContext.EXPECT().Option("goprivate").Return("").Once()
+	mockContext.EXPECT().OptionBool("skip-install").Return(false).Once()
+	mockContext.EXPECT().OptionBool("vendor").Return(false).Once()
+	mockContext.EXPECT().OptionBool("workspace").Return(false).Once()
+	mockContext.EXPECT().OptionBool("merge").Return(false).Once()
+	mockContext.EXPECT().Option("conflict").Return("").Once()
+	mockContext.EXPECT().Option("mirror").Return("").Once()
+	mockContext.EXPECT().OptionBool("verify").Return(false).Once()
+	mockContext.EXPECT().OptionBool("verify-tests").Return(false).Once()
+
+	// Mock getGitOptions
+	mockContext.EXPECT().Option("branch").Return("").Once()
+	mockContext.EXPECT().Option("remote").Return("").Once()
+	mockContext.EXPECT().OptionBool("git").Return(false).Once()
+
+	// Mock generateProject - getTemplateSource and cloneGoravel
+	setGitInstalled(t, true)
+	mockTemplateOptions(mockContext, "")
 	mockCloneResult := mocksprocess.NewResult(t)
 	mockCloneResult.EXPECT().Failed().Return(false).Once()
 	mockProcess.EXPECT().Run("git", "clone", "--depth=1", "https://github.com/goravel/goravel.git", mock.Anything).RunAndReturn(func(command string, args ...string) process.Result {
+		// The project is built in a staging directory next to the target
+		projectPath := args[len(args)-1]
+		assert.Equal(t, tmpDir, filepath.Dir(filepath.Dir(projectPath)))
+
 		// Simulate git clone by creating the project directory structure
 		err := os.MkdirAll(projectPath, 0755)
 		assert.Nil(t, err)
@@ -305,6 +669,11 @@ func main() {}`), 0644)
 	// Verify .env was created
 	envFile := filepath.Join(projectPath, ".env")
 	assert.FileExists(t, envFile)
+	envContent, err := os.ReadFile(envFile)
+	assert.Nil(t, err)
+	// The env values are set after the database, the missing keys follow the ones of the database
+	assert.Equal(t, "APP_NAME=\"Test Project\"\nDB_CONNECTION=sqlite\nDB_HOST=\nDB_PORT=\nDB_DATABASE=database/database.sqlite\nDB_USERNAME=\nDB_PASSWORD=\nAPP_URL=https://blog.test\nAPP_PORT=8080\nAPP_TIMEZONE=Europe/Paris\nAPP_LOCALE=en\n", string(envContent))
+	assert.FileExists(t, filepath.Join(projectPath, "database", "database.sqlite"))
 
 	// Verify module was replaced in go.mod
 	modFile := filepath.Join(projectPath, "go.mod")
@@ -312,6 +681,10 @@ func main() {}`), 0644)
 	assert.Nil(t, err)
 	assert.Contains(t, string(modContent), "module "+moduleName)
 
+	// Verify the template was cached
+	_, cached := readTemplateCache("https://github.com/goravel/goravel.git", "")
+	assert.True(t, cached)
+
 	// Verify module was replaced in go files
 	mainFile := filepath.Join(projectPath, "main.go")
 	mainContent, err := os.ReadFile(mainFile)
@@ -319,12 +692,601 @@ func main() {}`), 0644)
 	assert.Contains(t, string(mainContent), `"`+moduleName+`/app"`)
 }
 
+func TestCloneGoravel(t *testing.T) {
+	newCommand := &NewCommand{}
+	repo := "https://github.com/goravel/goravel.git"
+
+	t.Run("clone a tag", func(t *testing.T) {
+		mockProcess := mockNewProcess()
+		mockResult := mocksprocess.NewResult(t)
+		mockResult.EXPECT().Failed().Return(false).Once()
+		mockProcess.EXPECT().Run("git", "clone", "--depth=1", "--branch=v1.16.0", repo, "project").Return(mockResult).Once()
+
+		assert.Nil(t, newCommand.cloneGoravel(repo, "project", "v1.16.0", false))
+	})
+
+	t.Run("clone a commit", func(t *testing.T) {
+		mockProcess := mockNewProcess()
+		mockCloneResult := mocksprocess.NewResult(t)
+		mockCloneResult.EXPECT().Failed().Return(false).Once()
+		mockProcess.EXPECT().Run("git", "clone", repo, "project").Return(mockCloneResult).Once()
+		mockProcess.EXPECT().Quietly().Return(mockProcess).Once()
+		mockProcess.EXPECT().Path("project").Return(mockProcess).Once()
+		mockCheckoutResult := mocksprocess.NewResult(t)
+		mockCheckoutResult.EXPECT().Failed().Return(true).Once()
+		mockCheckoutResult.EXPECT().Error().Return(assert.AnError).Once()
+		mockProcess.EXPECT().Run("git", "checkout", "1a2b3c4d").Return(mockCheckoutResult).Once()
+
+		err := newCommand.cloneGoravel(repo, "project", "1a2b3c4d", false)
+		assert.ErrorContains(t, err, "failed to check out 1a2b3c4d")
+	})
+
+	t.Run("abort a stalled clone when a next mirror is left", func(t *testing.T) {
+		mockProcess := mockNewProcess()
+		mockProcess.EXPECT().Env(map[string]string{"GIT_HTTP_LOW_SPEED_LIMIT": "1000", "GIT_HTTP_LOW_SPEED_TIME": "30"}).Return(mockProcess).Once()
+		mockResult := mocksprocess.NewResult(t)
+		mockResult.EXPECT().Failed().Return(false).Once()
+		mockProcess.EXPECT().Run("git", "clone", "--depth=1", "https://gitee.com/goravel/goravel.git", "project").Return(mockResult).Once()
+
+		assert.Nil(t, newCommand.cloneGoravel("https://gitee.com/goravel/goravel.git", "project", "", true))
+	})
+}
+
+func TestGetTemplateRef(t *testing.T) {
+	newCommand := &NewCommand{}
+
+	mockContext := mocksconsole.NewContext(t)
+	mockContext.EXPECT().Option("ref").Return("v1.16.0").Once()
+	assert.Equal(t, "v1.16.0", newCommand.getTemplateRef(mockContext))
+
+	mockContext = mocksconsole.NewContext(t)
+	mockContext.EXPECT().Option("ref").Return("").Once()
+	mockContext.EXPECT().OptionBool("dev").Return(true).Once()
+	assert.Equal(t, "master", newCommand.getTemplateRef(mockContext))
+}
+
+func TestGetTemplateSource(t *testing.T) {
+	newCommand := &NewCommand{}
+	projectType := projectTypeEntry{Name: "api", Repo: "https://github.com/acme/goravel-api.git", Ref: "v1.0.0"}
+
+	mockContext := mocksconsole.NewContext(t)
+	mockContext.EXPECT().Option("template").Return("").Once()
+	source, err := newCommand.getTemplateSource(mockContext, projectType)
+	assert.Nil(t, err)
+	assert.Equal(t, templateSource{Kind: templateKindGit, Location: "https://github.com/acme/goravel-api.git", Ref: "v1.0.0"}, source)
+
+	// A custom template replaces the repo and the ref of the project type
+	mockContext = mocksconsole.NewContext(t)
+	mockContext.EXPECT().Option("template").Return("https://github.com/acme/starter.git").Once()
+	source, err = newCommand.getTemplateSource(mockContext, projectType)
+	assert.Nil(t, err)
+	assert.Equal(t, templateSource{Kind: templateKindGit, Location: "https://github.com/acme/starter.git"}, source)
+}
+
+func TestGenerateProject(t *testing.T) {
+	newCommand := &NewCommand{}
+
+	t.Run("keeps the existing directory when a step fails", func(t *testing.T) {
+		workDir := t.TempDir()
+		t.Chdir(workDir)
+		writeFile(t, filepath.Join(workDir, "blog", "main.go"), "package main")
+		template := t.TempDir()
+		writeFile(t, filepath.Join(template, "go.mod"), "module goravel\n")
+		setGitInstalled(t, true)
+
+		mockContext := mocksconsole.NewContext(t)
+		mockTemplateOptions(mockContext, template)
+
+		mockProcess := mockNewProcess()
+		mockProcess.EXPECT().WithSpinner("Installing dependencies").Return(mockProcess).Once()
+		mockProcess.EXPECT().Path(mock.Anything).Return(mockProcess).Once()
+		mockResult := mocksprocess.NewResult(t)
+		mockResult.EXPECT().Failed().Return(true).Once()
+		mockResult.EXPECT().Error().Return(assert.AnError).Once()
+		mockProcess.EXPECT().Run("go", "mod", "tidy").Return(mockResult).Once()
+
+		var err error
+		color.CaptureOutput(func(w io.Writer) {
+			err = newCommand.generateProject(mockContext, projectOptions{Module: "goravel", Name: "blog"})
+		})
+		assert.ErrorContains(t, err, "failed to install dependencies")
+
+		assert.FileExists(t, filepath.Join(workDir, "blog", "main.go"))
+		assert.NoFileExists(t, filepath.Join(workDir, "blog", "go.mod"))
+		entries, err := os.ReadDir(workDir)
+		assert.Nil(t, err)
+		assert.Len(t, entries, 1)
+	})
+
+	t.Run("reports the steps as events", func(t *testing.T) {
+		workDir := t.TempDir()
+		t.Chdir(workDir)
+		template := t.TempDir()
+		writeFile(t, filepath.Join(template, "go.mod"), "module goravel\n")
+		setGitInstalled(t, true)
+		output := setEventOutput(t)
+		events, err := newEventStream("json")
+		assert.Nil(t, err)
+
+		mockContext := mocksconsole.NewContext(t)
+		mockTemplateOptions(mockContext, template)
+
+		mockProcess := mockNewProcess()
+		mockProcess.EXPECT().WithSpinner("Installing dependencies").Return(mockProcess).Once()
+		mockProcess.EXPECT().Path(mock.Anything).Return(mockProcess).Once()
+		mockResult := mocksprocess.NewResult(t)
+		mockResult.EXPECT().Failed().Return(true).Once()
+		mockResult.EXPECT().Error().Return(assert.AnError).Once()
+		mockProcess.EXPECT().Run("go", "mod", "tidy").Return(mockResult).Once()
+
+		color.CaptureOutput(func(w io.Writer) {
+			err = newCommand.generateProject(mockContext, projectOptions{Events: events, Module: "goravel", Name: "blog"})
+		})
+		assert.ErrorContains(t, err, "failed to install dependencies")
+
+		lines := readEvents(t, output)
+		if !assert.Len(t, lines, 4) {
+			return
+		}
+		assert.Equal(t, "step_started", lines[0]["event"])
+		assert.Equal(t, "fetch_template", lines[0]["step"])
+		assert.Equal(t, "succeeded", lines[1]["status"])
+		assert.Equal(t, "init_project", lines[2]["step"])
+		assert.Equal(t, "failed", lines[3]["status"])
+		assert.Contains(t, lines[3]["error"], "failed to install dependencies")
+	})
+
+	t.Run("sets the env values after the database", func(t *testing.T) {
+		workDir := t.TempDir()
+		t.Chdir(workDir)
+		template := t.TempDir()
+		writeFile(t, filepath.Join(template, "go.mod"), "module goravel\n")
+		writeFile(t, filepath.Join(template, ".env.example"), "APP_NAME=Goravel\nDB_CONNECTION=mysql\nDB_HOST=127.0.0.1\nDB_PORT=3306\n")
+		setGitInstalled(t, true)
+
+		mockContext := mocksconsole.NewContext(t)
+		mockTemplateOptions(mockContext, template)
+
+		var err error
+		color.CaptureOutput(func(w io.Writer) {
+			err = newCommand.generateProject(mockContext, projectOptions{
+				Database: "postgres",
+				Env:      [][2]string{{"DB_HOST", "x"}},
+				GoModule: goModuleOptions{SkipInstall: true},
+				Module:   "goravel",
+				Name:     "blog",
+			})
+		})
+		assert.Nil(t, err)
+
+		env, err := readEnvValues(filepath.Join(workDir, "blog", ".env"))
+		assert.Nil(t, err)
+		assert.Equal(t, "postgres", env["DB_CONNECTION"])
+		assert.Equal(t, "x", env["DB_HOST"])
+		assert.Equal(t, "5432", env["DB_PORT"])
+	})
+
+	t.Run("renders the variables before renaming the module", func(t *testing.T) {
+		workDir := t.TempDir()
+		t.Chdir(workDir)
+		template := t.TempDir()
+		writeFile(t, filepath.Join(template, "go.mod"), "module goravel\n")
+		writeFile(t, filepath.Join(template, "main.go"), "package main\n\nimport \"goravel/config\"\n\nvar _ = config.Port\n")
+		writeFile(t, filepath.Join(template, "config", "http.go"), "package config\n\nconst Port = {{.http_port}}\n")
+		writeFile(t, filepath.Join(template, templateManifestFile), "variables:\n  - name: http_port\n    type: int\n    default: \"3000\"\nrender:\n  - config/http.go\n")
+		writeFile(t, filepath.Join(template, ".env.example"), "APP_NAME=Goravel\n")
+		setGitInstalled(t, true)
+
+		mockContext := mocksconsole.NewContext(t)
+		mockTemplateOptions(mockContext, template)
+		mockContext.EXPECT().Spinner(`Updating module name to "github.com/acme/svc"`, mock.Anything).RunAndReturn(func(_ string, option console.SpinnerOption) error {
+			return option.Action()
+		}).Once()
+
+		var err error
+		color.CaptureOutput(func(w io.Writer) {
+			err = newCommand.generateProject(mockContext, projectOptions{
+				GoModule:      goModuleOptions{SkipInstall: true},
+				Module:        "github.com/acme/svc",
+				Name:          "svc",
+				NoInteraction: true,
+				Vars:          map[string]string{"http_port": "8080"},
+			})
+		})
+		assert.Nil(t, err)
+
+		for file, content := range map[string]string{
+			"config/http.go": "package config\n\nconst Port = 8080\n",
+			"main.go":        "package main\n\nimport \"github.com/acme/svc/config\"\n\nvar _ = config.Port\n",
+		} {
+			data, err := os.ReadFile(filepath.Join(workDir, "svc", file))
+			assert.Nil(t, err)
+			assert.Equal(t, content, string(data))
+		}
+	})
+}
+
+func TestGenerateProjectDryRun(t *testing.T) {
+	newCommand := &NewCommand{}
+	workDir := t.TempDir()
+	t.Chdir(workDir)
+	writeFile(t, filepath.Join(workDir, "blog", "notes.txt"), "keep me")
+	template := t.TempDir()
+	writeFile(t, filepath.Join(template, "go.mod"), "module goravel\n")
+	writeFile(t, filepath.Join(template, "main.go"), "package main\n\nimport _ \"goravel/app\"\n")
+	writeFile(t, filepath.Join(template, "main_test.go"), "package main\n")
+	writeFile(t, filepath.Join(template, ".env.example"), "DB_CONNECTION=postgres\n")
+	setGitInstalled(t, true)
+
+	mockContext := mocksconsole.NewContext(t)
+	mockTemplateOptions(mockContext, template)
+	mockContext.EXPECT().Spinner("Updating module name to \"github.com/acme/blog\"", mock.Anything).RunAndReturn(func(_ string, opt console.SpinnerOption) error {
+		return opt.Action()
+	}).Once()
+
+	// No command is run during a dry run
+	frameworkmock.Factory().Process()
+
+	var err error
+	captureOutput := color.CaptureOutput(func(w io.Writer) {
+		err = newCommand.generateProject(mockContext, projectOptions{
+			Database: "sqlite",
+			DryRun:   true,
+			Facades:  []string{"Cache", "Orm"},
+			Git:      &gitRepositoryOptions{Branch: "main"},
+			GoModule: goModuleOptions{Vendor: true},
+			Type:     projectTypeEntry{Name: "lite", InstallFacades: true},
+			Module:   "github.com/acme/blog",
+			Name:     "blog",
+		})
+	})
+	assert.Nil(t, err)
+
+	for _, expected := range []string{
+		"1. Fetch the directory template " + template,
+		`2. Update the module name from "goravel" to "github.com/acme/blog"`,
+		"4. Run `go mod tidy`",
+		"6. Run `go run . artisan key:generate`",
+		"7. Configure the SQLite database in .env",
+		"8. Run `go run . artisan package:install --default Cache Orm`",
+		"9. Run `go run . artisan package:install github.com/goravel/sqlite`",
+		"10. Run `go mod vendor`",
+		"11. Run `git init --initial-branch=main && git add --all && git commit --quiet --message \"Initial commit from Goravel Installer",
+		"12. Move the existing directory " + filepath.Join(workDir, "blog") + " to ",
+		"13. Create " + filepath.Join(workDir, "blog"),
+		"Files compared with the template: 2 added, 2 modified, 1 removed",
+		"+ .env\n",
+		"+ database/database.sqlite\n",
+		"~ go.mod\n",
+		"~ main.go\n",
+		"- main_test.go\n",
+	} {
+		assert.Contains(t, captureOutput, expected)
+	}
+
+	assert.FileExists(t, filepath.Join(workDir, "blog", "notes.txt"))
+	assert.NoFileExists(t, filepath.Join(workDir, "blog", "go.mod"))
+	entries, err := os.ReadDir(workDir)
+	assert.Nil(t, err)
+	assert.Len(t, entries, 1)
+}
+
+func TestGenerateProjectInWorkspace(t *testing.T) {
+	newCommand := &NewCommand{}
+	workDir := t.TempDir()
+	t.Chdir(workDir)
+	writeFile(t, filepath.Join(workDir, "go.work"), "go 1.25\n")
+	template := t.TempDir()
+	writeFile(t, filepath.Join(template, "go.mod"), "module goravel\n")
+	writeFile(t, filepath.Join(template, ".env.example"), "APP_NAME=Goravel\n")
+	setGitInstalled(t, true)
+	t.Setenv("GOWORK", "")
+
+	mockContext := mocksconsole.NewContext(t)
+	mockTemplateOptions(mockContext, template)
+
+	mockProcess := mockNewProcess()
+	mockProcess.EXPECT().WithSpinner(mock.Anything).Return(mockProcess).Twice()
+	mockProcess.EXPECT().Path(mock.Anything).Return(mockProcess).Twice()
+	for _, args := range [][]any{{"mod", "tidy"}, {"run", ".", "artisan", "key:generate"}} {
+		mockResult := mocksprocess.NewResult(t)
+		mockResult.EXPECT().Failed().RunAndReturn(func() bool {
+			// The staging directory is not a module of the workspace, go only runs in it without the workspace mode
+			assert.Equal(t, "off", os.Getenv("GOWORK"))
+
+			return false
+		}).Once()
+		mockProcess.EXPECT().Run("go", args...).Return(mockResult).Once()
+	}
+	mockProcess.EXPECT().Quietly().Return(mockProcess).Once()
+	mockProcess.EXPECT().Path(workDir).Return(mockProcess).Once()
+	mockResult := mocksprocess.NewResult(t)
+	mockResult.EXPECT().Failed().Return(false).Once()
+	mockProcess.EXPECT().Run("go", "work", "use", "./services/billing").Return(mockResult).Once()
+
+	var err error
+	color.CaptureOutput(func(w io.Writer) {
+		err = newCommand.generateProject(mockContext, projectOptions{
+			Module:    "goravel",
+			Name:      "services/billing",
+			Workspace: filepath.Join(workDir, "go.work"),
+		})
+	})
+	assert.Nil(t, err)
+	assert.FileExists(t, filepath.Join(workDir, "services", "billing", "go.mod"))
+	assert.Equal(t, "", os.Getenv("GOWORK"))
+}
+
+func TestGenerateProjectMerge(t *testing.T) {
+	newCommand := &NewCommand{}
+	parentDir := t.TempDir()
+	workDir := filepath.Join(parentDir, "blog")
+	writeFile(t, filepath.Join(workDir, "README.md"), "# Blog")
+	writeFile(t, filepath.Join(workDir, ".git", "HEAD"), "ref: refs/heads/main")
+	t.Chdir(workDir)
+	template := t.TempDir()
+	writeFile(t, filepath.Join(template, "go.mod"), "module goravel\n")
+	writeFile(t, filepath.Join(template, "README.md"), "# Goravel")
+	writeFile(t, filepath.Join(template, ".env.example"), "APP_NAME=Goravel\n")
+	setGitInstalled(t, true)
+
+	// The staging directory of a merge isn't created next to the target
+	assert.Nil(t, os.Chmod(parentDir, 0555))
+	t.Cleanup(func() {
+		_ = os.Chmod(parentDir, 0755)
+	})
+
+	mockContext := mocksconsole.NewContext(t)
+	mockTemplateOptions(mockContext, template)
+	mockContext.EXPECT().Choice("What do you want to do with README.md?", mock.Anything).Return(mergeConflictKeep, nil).Once()
+
+	var err error
+	captureOutput := color.CaptureOutput(func(w io.Writer) {
+		err = newCommand.generateProject(mockContext, projectOptions{
+			GoModule: goModuleOptions{SkipInstall: true},
+			Merge:    true,
+			Module:   "goravel",
+			Name:     ".",
+		})
+	})
+	assert.Nil(t, err)
+	assert.Contains(t, captureOutput, "Merged the project into "+workDir)
+
+	for file, content := range map[string]string{
+		"README.md": "# Blog",
+		".git/HEAD": "ref: refs/heads/main",
+		"go.mod":    "module goravel\n",
+		".env":      "APP_NAME=Goravel\n",
+	} {
+		data, err := os.ReadFile(filepath.Join(workDir, file))
+		assert.Nil(t, err)
+		assert.Equal(t, content, string(data))
+	}
+	entries, err := os.ReadDir(parentDir)
+	assert.Nil(t, err)
+	assert.Len(t, entries, 1)
+
+	t.Run("refuses to initialize another git repository", func(t *testing.T) {
+		err := newCommand.generateProject(mocksconsole.NewContext(t), projectOptions{
+			Git:   &gitRepositoryOptions{},
+			Merge: true,
+			Name:  ".",
+		})
+		assert.EqualError(t, err, "the directory already has a git repository, remove the --git, --branch and --remote options to merge into it")
+	})
+}
+
+func TestGenerateProjectCleanup(t *testing.T) {
+	newCommand := &NewCommand{cleanup: newProjectCleanup()}
+	workDir := t.TempDir()
+	t.Chdir(workDir)
+	template := t.TempDir()
+	writeFile(t, filepath.Join(template, "README.md"), "# Goravel")
+	setGitInstalled(t, true)
+
+	mockContext := mocksconsole.NewContext(t)
+	mockTemplateOptions(mockContext, template)
+
+	var err error
+	captureOutput := color.CaptureOutput(func(w io.Writer) {
+		err = newCommand.generateProject(mockContext, projectOptions{
+			GoModule: goModuleOptions{SkipInstall: true},
+			Module:   "goravel",
+			Name:     "services/billing",
+		})
+	})
+	assert.NotNil(t, err)
+	assert.Contains(t, captureOutput, "Cleaned up the partially created project:")
+	assert.Contains(t, captureOutput, "Removed "+filepath.Join(workDir, "services"))
+
+	entries, err := os.ReadDir(workDir)
+	assert.Nil(t, err)
+	assert.Empty(t, entries)
+
+	// The command is shut down after it fails, there is nothing left to clean up
+	assert.Nil(t, newCommand.Shutdown(mockContext))
+}
+
+func TestShutdown(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	interrupted := func() bool {
+		return ctx.Err() != nil
+	}
+
+	t.Run("after a normal return", func(t *testing.T) {
+		cleanup := newProjectCleanup()
+		cleanup.Add("Removed the blog", func() error {
+			return nil
+		})
+		cleanup.Commit()
+		newCommand := &NewCommand{cleanup: cleanup, interrupted: interrupted}
+
+		assert.Nil(t, newCommand.Shutdown(mocksconsole.NewContext(t)))
+	})
+
+	t.Run("cleans up an interrupted command", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "blog")
+		assert.Nil(t, os.Mkdir(path, 0755))
+		cleanup := newProjectCleanup()
+		cleanup.Add("Removed "+path, func() error {
+			return os.RemoveAll(path)
+		})
+		newCommand := &NewCommand{cleanup: cleanup, interrupted: interrupted}
+
+		var err error
+		captureOutput := color.CaptureOutput(func(w io.Writer) {
+			err = newCommand.Shutdown(mocksconsole.NewContext(t))
+		})
+		assert.EqualError(t, err, "the command has been interrupted, the project has not been created")
+		assert.Contains(t, captureOutput, "Removed "+path)
+		assert.NoDirExists(t, path)
+	})
+
+	t.Run("interrupted before anything is written", func(t *testing.T) {
+		cancel()
+		newCommand := &NewCommand{cleanup: newProjectCleanup(), interrupted: interrupted}
+
+		assert.EqualError(t, newCommand.Shutdown(mocksconsole.NewContext(t)), "the command has been interrupted, the project has not been created")
+	})
+}
+
+func TestGetWorkspace(t *testing.T) {
+	newCommand := &NewCommand{}
+
+	t.Run("without workspace", func(t *testing.T) {
+		t.Chdir(t.TempDir())
+
+		mockContext := mocksconsole.NewContext(t)
+		mockContext.EXPECT().OptionBool("workspace").Return(false).Once()
+		workspace, err := newCommand.getWorkspace(mockContext, "blog", false)
+		assert.Nil(t, err)
+		assert.Equal(t, "", workspace)
+
+		mockContext = mocksconsole.NewContext(t)
+		mockContext.EXPECT().OptionBool("workspace").Return(true).Once()
+		_, err = newCommand.getWorkspace(mockContext, "blog", false)
+		assert.EqualError(t, err, "no go.work is found in the parent directories, remove the --workspace option")
+	})
+
+	t.Run("with workspace", func(t *testing.T) {
+		workDir := t.TempDir()
+		t.Chdir(workDir)
+		writeFile(t, filepath.Join(workDir, "go.work"), "go 1.25\n")
+		goWork := filepath.Join(workDir, "go.work")
+
+		mockContext := mocksconsole.NewContext(t)
+		mockContext.EXPECT().OptionBool("workspace").Return(true).Once()
+		workspace, err := newCommand.getWorkspace(mockContext, "services/billing", true)
+		assert.Nil(t, err)
+		assert.Equal(t, goWork, workspace)
+
+		mockContext = mocksconsole.NewContext(t)
+		mockContext.EXPECT().OptionBool("workspace").Return(false).Once()
+		mockContext.EXPECT().Confirm("Do you want to add the project to the Go workspace "+goWork+"?", console.ConfirmOption{Default: true}).Return(true).Once()
+		workspace, err = newCommand.getWorkspace(mockContext, "services/billing", false)
+		assert.Nil(t, err)
+		assert.Equal(t, goWork, workspace)
+
+		mockContext = mocksconsole.NewContext(t)
+		mockContext.EXPECT().OptionBool("workspace").Return(false).Once()
+		color.CaptureOutput(func(w io.Writer) {
+			workspace, err = newCommand.getWorkspace(mockContext, "services/billing", true)
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, "", workspace)
+	})
+}
+
+func TestMoveProject(t *testing.T) {
+	newCommand := &NewCommand{}
+
+	t.Run("move to a new directory", func(t *testing.T) {
+		workDir := t.TempDir()
+		path := filepath.Join(workDir, ".blog-1", "blog")
+		writeFile(t, filepath.Join(path, "go.mod"), "module blog\n")
+		target := filepath.Join(workDir, "blog")
+
+		assert.Nil(t, newCommand.moveProject(path, target))
+		assert.FileExists(t, filepath.Join(target, "go.mod"))
+		assert.NoDirExists(t, path)
+	})
+
+	t.Run("back up the existing directory", func(t *testing.T) {
+		workDir := t.TempDir()
+		path := filepath.Join(workDir, ".blog-1", "blog")
+		writeFile(t, filepath.Join(path, "go.mod"), "module blog\n")
+		target := filepath.Join(workDir, "blog")
+		writeFile(t, filepath.Join(target, "notes.txt"), "keep me")
+
+		captureOutput := color.CaptureOutput(func(w io.Writer) {
+			assert.Nil(t, newCommand.moveProject(path, target))
+		})
+
+		assert.Contains(t, captureOutput, "Moved the existing directory to "+target+".backup-")
+		assert.FileExists(t, filepath.Join(target, "go.mod"))
+		assert.NoFileExists(t, filepath.Join(target, "notes.txt"))
+
+		backups, err := filepath.Glob(target + ".backup-*")
+		assert.Nil(t, err)
+		assert.Len(t, backups, 1)
+		assert.FileExists(t, filepath.Join(backups[0], "notes.txt"))
+	})
+}
+
+func TestHandleWithoutTerminal(t *testing.T) {
+	newCommand := &NewCommand{}
+	isolateUserDirs(t)
+	setStdinIsTerminal(t, false)
+
+	mockContext := mocksconsole.NewContext(t)
+	mockContext.EXPECT().Option("format").Return("").Once()
+	mockContext.EXPECT().NewLine().Once()
+	mockContext.EXPECT().Option("catalog").Return("").Once()
+	mockContext.EXPECT().OptionBool("no-interaction").Return(false).Once()
+	mockContext.EXPECT().Argument(0).Return("blog").Once()
+	mockContext.EXPECT().Option("type").Return("").Once()
+	mockContext.EXPECT().Option("module").Return("").Once()
+	mockContext.EXPECT().Option("database").Return("").Once()
+
+	captureOutput := color.CaptureOutput(func(w io.Writer) {
+		assert.Nil(t, newCommand.Handle(mockContext))
+	})
+
+	assert.Contains(t, captureOutput, "stdin is not a terminal")
+	assert.Contains(t, captureOutput, "--type, --module, --database")
+}
+
+func TestHandleInvalidProjectType(t *testing.T) {
+	newCommand := &NewCommand{}
+	isolateUserDirs(t)
+	t.Chdir(t.TempDir())
+	output := setEventOutput(t)
+
+	mockContext := mocksconsole.NewContext(t)
+	mockContext.EXPECT().Option("format").Return("json").Once()
+	mockContext.EXPECT().NewLine().Once()
+	mockContext.EXPECT().Option("catalog").Return("").Once()
+	mockContext.EXPECT().OptionBool("no-interaction").Return(true).Once()
+	mockContext.EXPECT().Argument(0).Return("blog").Once()
+	mockContext.EXPECT().OptionBool("force").Return(false).Once()
+	mockContext.EXPECT().Option("type").Return("nope").Once()
+
+	assert.EqualError(t, newCommand.Handle(mockContext), `failed to get project type: invalid project type "nope", use one of: goravel, lite`)
+
+	events := readEvents(t, output)
+	if assert.NotEmpty(t, events) {
+		assert.Equal(t, "error", events[len(events)-1]["event"])
+		assert.Contains(t, events[len(events)-1]["message"], `failed to get project type: invalid project type "nope"`)
+	}
+}
+
 func TestInitProject(t *testing.T) {
 	newCommand := &NewCommand{}
 
 	t.Run("successfully initializes project", func(t *testing.T) {
 		mockFactory := frameworkmock.Factory()
 		mockProcess := mockFactory.Process()
+		mockProcess.EXPECT().WithContext(mock.Anything).Return(mockProcess).Maybe()
 
 		tmpDir, err := os.MkdirTemp("", "test-init-project")
 		assert.Nil(t, err)
@@ -364,7 +1326,7 @@ func TestInitProject(t *testing.T) {
 		mockKeyGenResult.EXPECT().Failed().Return(false).Once()
 		mockProcess.EXPECT().Run("go", "run", ".", "artisan", "key:generate").Return(mockKeyGenResult).Once()
 
-		err = newCommand.initProject(tmpDir)
+		err = newCommand.initProject(tmpDir, goModuleOptions{}, nil)
 		assert.Nil(t, err)
 
 		// Verify .git and .github were removed
@@ -379,9 +1341,44 @@ func TestInitProject(t *testing.T) {
 		assert.Contains(t, string(envContent), "APP_NAME=TestApp")
 	})
 
+	t.Run("forwards the go module settings", func(t *testing.T) {
+		mockProcess := mockNewProcess()
+		tmpDir := t.TempDir()
+		writeFile(t, filepath.Join(tmpDir, ".env.example"), "APP_NAME=TestApp")
+		env := map[string]string{"GOPROXY": "https://goproxy.example.com", "GOPRIVATE": "github.com/acme/*"}
+
+		for spinner, args := range map[string][]any{
+			"Installing dependencies":    {"mod", "tidy"},
+			"Generating application key": {"run", ".", "artisan", "key:generate"},
+		} {
+			mockProcess.EXPECT().WithSpinner(spinner).Return(mockProcess).Once()
+			mockResult := mocksprocess.NewResult(t)
+			mockResult.EXPECT().Failed().Return(false).Once()
+			mockProcess.EXPECT().Run("go", args...).Return(mockResult).Once()
+		}
+		mockProcess.EXPECT().Path(tmpDir).Return(mockProcess).Twice()
+		mockProcess.EXPECT().Env(env).Return(mockProcess).Twice()
+
+		color.CaptureOutput(func(w io.Writer) {
+			assert.Nil(t, newCommand.initProject(tmpDir, goModuleOptions{Proxy: "https://goproxy.example.com", Private: "github.com/acme/*", Vendor: true}, nil))
+		})
+	})
+
+	t.Run("skips the dependencies and the key", func(t *testing.T) {
+		frameworkmock.Factory().Process()
+		tmpDir := t.TempDir()
+		writeFile(t, filepath.Join(tmpDir, ".env.example"), "APP_NAME=TestApp")
+
+		color.CaptureOutput(func(w io.Writer) {
+			assert.Nil(t, newCommand.initProject(tmpDir, goModuleOptions{SkipInstall: true, Vendor: true}, nil))
+		})
+		assert.FileExists(t, filepath.Join(tmpDir, ".env"))
+	})
+
 	t.Run("fails when go mod tidy fails", func(t *testing.T) {
 		mockFactory := frameworkmock.Factory()
 		mockProcess := mockFactory.Process()
+		mockProcess.EXPECT().WithContext(mock.Anything).Return(mockProcess).Maybe()
 
 		tmpDir, err := os.MkdirTemp("", "test-init-project-fail")
 		assert.Nil(t, err)
@@ -402,7 +1399,7 @@ func TestInitProject(t *testing.T) {
 		mockModTidyResult.EXPECT().Error().Return(assert.AnError).Once()
 		mockProcess.EXPECT().Run("go", "mod", "tidy").Return(mockModTidyResult).Once()
 
-		err = newCommand.initProject(tmpDir)
+		err = newCommand.initProject(tmpDir, goModuleOptions{}, nil)
 		assert.NotNil(t, err)
 		assert.Contains(t, err.Error(), "failed to install dependencies")
 	})
@@ -410,6 +1407,7 @@ func TestInitProject(t *testing.T) {
 	t.Run("fails when key:generate fails", func(t *testing.T) {
 		mockFactory := frameworkmock.Factory()
 		mockProcess := mockFactory.Process()
+		mockProcess.EXPECT().WithContext(mock.Anything).Return(mockProcess).Maybe()
 
 		tmpDir, err := os.MkdirTemp("", "test-init-project-keyfail")
 		assert.Nil(t, err)
@@ -437,7 +1435,7 @@ func TestInitProject(t *testing.T) {
 		mockKeyGenResult.EXPECT().Error().Return(assert.AnError).Once()
 		mockProcess.EXPECT().Run("go", "run", ".", "artisan", "key:generate").Return(mockKeyGenResult).Once()
 
-		err = newCommand.initProject(tmpDir)
+		err = newCommand.initProject(tmpDir, goModuleOptions{}, nil)
 		assert.NotNil(t, err)
 		assert.Contains(t, err.Error(), "failed to generate app key")
 	})
@@ -445,6 +1443,7 @@ func TestInitProject(t *testing.T) {
 	t.Run("sets artisan file permissions when artisan exists", func(t *testing.T) {
 		mockFactory := frameworkmock.Factory()
 		mockProcess := mockFactory.Process()
+		mockProcess.EXPECT().WithContext(mock.Anything).Return(mockProcess).Maybe()
 
 		tmpDir, err := os.MkdirTemp("", "test-init-artisan")
 		assert.Nil(t, err)
@@ -476,7 +1475,7 @@ func TestInitProject(t *testing.T) {
 		mockKeyGenResult.EXPECT().Failed().Return(false).Once()
 		mockProcess.EXPECT().Run("go", "run", ".", "artisan", "key:generate").Return(mockKeyGenResult).Once()
 
-		err = newCommand.initProject(tmpDir)
+		err = newCommand.initProject(tmpDir, goModuleOptions{}, nil)
 		assert.Nil(t, err)
 
 		// Verify artisan permissions were set correctly
@@ -525,7 +1524,7 @@ func main() {}`), os.ModePerm)
 		return opt.Action()
 	}).Once()
 
-	err = newCommand.replaceModule(mockContext, tmpDir, newModule)
+	err = newCommand.replaceModule(mockContext, nil, tmpDir, newModule, moduleRenameRules)
 	assert.Nil(t, err)
 
 	modContent, err := os.ReadFile(modFile)
@@ -542,3 +1541,15 @@ func main() {}`), os.ModePerm)
 	assert.Nil(t, err)
 	assert.Equal(t, "This is a test file.", string(invalidContent))
 }
+
+func setStdinIsTerminal(t *testing.T, isTerminal bool) {
+	t.Helper()
+
+	original := stdinIsTerminal
+	stdinIsTerminal = func() bool {
+		return isTerminal
+	}
+	t.Cleanup(func() {
+		stdinIsTerminal = original
+	})
+}
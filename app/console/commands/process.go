@@ -0,0 +1,18 @@
+package commands
+
+import (
+	"context"
+
+	contractsprocess "github.com/goravel/framework/contracts/process"
+
+	"github.com/goravel/installer/app/facades"
+)
+
+// processContext is bound to the child processes of the new command, they are stopped once it's cancelled, e.g. when
+// the user presses Ctrl-C.
+var processContext context.Context = context.Background()
+
+// newProcess Get a process that is stopped when the new command is interrupted.
+func newProcess() contractsprocess.Process {
+	return facades.Process().WithContext(processContext)
+}
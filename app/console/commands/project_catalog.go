@@ -0,0 +1,168 @@
+package commands
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/goravel/framework/errors"
+	"github.com/goravel/framework/support/color"
+	"go.yaml.in/yaml/v3"
+	"golang.org/x/mod/semver"
+
+	"github.com/goravel/installer/support"
+)
+
+// projectCatalogHTTPClient is used to download a remote catalog, it can be replaced in tests.
+var projectCatalogHTTPClient = &http.Client{Timeout: 10 * time.Second}
+
+// projectTypeEntry describes a starter of the catalog, the project is created from its repo at its ref. The facades
+// are chosen and installed after the creation when InstallFacades is true, like in a lite project.
+type projectTypeEntry struct {
+	Name                string `yaml:"name"`
+	Description         string `yaml:"description"`
+	Repo                string `yaml:"repo"`
+	Ref                 string `yaml:"ref"`
+	InstallFacades      bool   `yaml:"install_facades"`
+	MinInstallerVersion string `yaml:"min_installer_version"`
+}
+
+// Supported Report whether the installer is recent enough to create the project type.
+func (r projectTypeEntry) Supported() bool {
+	return r.MinInstallerVersion == "" || semver.Compare(support.Version, r.MinInstallerVersion) >= 0
+}
+
+// projectCatalog is the index of the project types, a YAML or JSON file such as:
+//
+//	types:
+//	  - name: api
+//	    description: Only includes the facades of an API
+//	    repo: https://github.com/acme/goravel-api.git
+//	    ref: v1.0.0
+//	    install_facades: false
+//	    min_installer_version: v1.18.0
+type projectCatalog struct {
+	Types []projectTypeEntry `yaml:"types"`
+}
+
+// builtinProjectCatalog is used when no catalog is configured or the configured one can't be loaded.
+var builtinProjectCatalog = projectCatalog{
+	Types: []projectTypeEntry{
+		{Name: "goravel", Description: "Includes all facades", Repo: goravelRepo},
+		{Name: "lite", Description: "Only includes essential facades", Repo: goravelLiteRepo, InstallFacades: true},
+	},
+}
+
+// loadProjectCatalog Load the catalog of a local path or an HTTP(S) URL, the built-in catalog is returned when the
+// location is empty or the catalog can't be loaded.
+func loadProjectCatalog(location string) projectCatalog {
+	if location == "" {
+		return builtinProjectCatalog
+	}
+
+	catalog, err := readProjectCatalog(location)
+	if err != nil {
+		color.Warnln(fmt.Sprintf("Failed to load the project catalog %s: %s, falling back to the built-in one", location, err))
+		return builtinProjectCatalog
+	}
+
+	return catalog
+}
+
+func readProjectCatalog(location string) (projectCatalog, error) {
+	var catalog projectCatalog
+
+	content, err := readProjectCatalogContent(location)
+	if err != nil {
+		return catalog, err
+	}
+	if err := yaml.Unmarshal(content, &catalog); err != nil {
+		return catalog, fmt.Errorf("invalid catalog: %s", err)
+	}
+	if err := catalog.Validate(); err != nil {
+		return catalog, err
+	}
+
+	return catalog, nil
+}
+
+func readProjectCatalogContent(location string) ([]byte, error) {
+	if !isHTTPURL(location) {
+		path, err := expandHomePath(location)
+		if err != nil {
+			return nil, err
+		}
+
+		return os.ReadFile(path)
+	}
+
+	response, err := projectCatalogHTTPClient.Get(location)
+	if err != nil {
+		return nil, err
+	}
+	defer errors.Ignore(response.Body.Close)
+
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %s", response.Status)
+	}
+
+	return io.ReadAll(response.Body)
+}
+
+// Validate Verify that every project type has a unique name and a repo, and that the minimum installer versions are
+// semantic versions.
+func (r projectCatalog) Validate() error {
+	if len(r.Types) == 0 {
+		return errors.New("the catalog has no project types")
+	}
+
+	seen := make(map[string]bool, len(r.Types))
+	for _, entry := range r.Types {
+		if entry.Name == "" || entry.Repo == "" {
+			return errors.New("every project type of the catalog requires a name and a repo")
+		}
+		if seen[entry.Name] {
+			return fmt.Errorf("the project type %q is declared twice", entry.Name)
+		}
+		if entry.MinInstallerVersion != "" && !semver.IsValid(entry.MinInstallerVersion) {
+			return fmt.Errorf("invalid min_installer_version %q of the project type %q, use a version such as v1.18.0", entry.MinInstallerVersion, entry.Name)
+		}
+
+		seen[entry.Name] = true
+	}
+
+	return nil
+}
+
+// Supported Get the project types the installer is recent enough to create.
+func (r projectCatalog) Supported() []projectTypeEntry {
+	return slices.DeleteFunc(slices.Clone(r.Types), func(entry projectTypeEntry) bool {
+		return !entry.Supported()
+	})
+}
+
+// Find Get the project type of the name, it fails when the installer is too old to create it.
+func (r projectCatalog) Find(name string) (projectTypeEntry, error) {
+	index := slices.IndexFunc(r.Types, func(entry projectTypeEntry) bool {
+		return entry.Name == name
+	})
+	if index == -1 {
+		names := make([]string, len(r.Types))
+		for i, entry := range r.Types {
+			names[i] = entry.Name
+		}
+
+		return projectTypeEntry{}, fmt.Errorf("invalid project type %q, use one of: %s", name, strings.Join(names, ", "))
+	}
+
+	entry := r.Types[index]
+	if !entry.Supported() {
+		return projectTypeEntry{}, fmt.Errorf("the project type %q requires the installer %s or newer, run the upgrade command first", name, entry.MinInstallerVersion)
+	}
+
+	return entry, nil
+}
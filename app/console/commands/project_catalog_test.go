@@ -0,0 +1,94 @@
+package commands
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	"github.com/goravel/framework/support/color"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoadProjectCatalog(t *testing.T) {
+	t.Run("built-in catalog", func(t *testing.T) {
+		assert.Equal(t, builtinProjectCatalog, loadProjectCatalog(""))
+	})
+
+	t.Run("local YAML catalog", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "catalog.yaml")
+		writeFile(t, path, `types:
+  - name: api
+    description: Only includes the facades of an API
+    repo: https://github.com/acme/goravel-api.git
+    ref: v1.0.0
+    install_facades: true
+    min_installer_version: v1.17.0
+`)
+
+		assert.Equal(t, projectCatalog{Types: []projectTypeEntry{{
+			Name:                "api",
+			Description:         "Only includes the facades of an API",
+			Repo:                "https://github.com/acme/goravel-api.git",
+			Ref:                 "v1.0.0",
+			InstallFacades:      true,
+			MinInstallerVersion: "v1.17.0",
+		}}}, loadProjectCatalog(path))
+	})
+
+	t.Run("remote JSON catalog", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = io.WriteString(w, `{"types": [{"name": "microservice", "repo": "git@github.com:acme/microservice.git"}]}`)
+		}))
+		defer server.Close()
+
+		assert.Equal(t, projectCatalog{Types: []projectTypeEntry{{
+			Name: "microservice",
+			Repo: "git@github.com:acme/microservice.git",
+		}}}, loadProjectCatalog(server.URL+"/catalog.json"))
+	})
+
+	t.Run("falls back to the built-in catalog", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "catalog.yaml")
+		writeFile(t, path, "types:\n  - name: api\n")
+
+		var catalog projectCatalog
+		captureOutput := color.CaptureOutput(func(w io.Writer) {
+			catalog = loadProjectCatalog(path)
+		})
+		assert.Equal(t, builtinProjectCatalog, catalog)
+		assert.Contains(t, captureOutput, "every project type of the catalog requires a name and a repo, falling back to the built-in one")
+	})
+}
+
+func TestProjectCatalogValidate(t *testing.T) {
+	assert.Nil(t, builtinProjectCatalog.Validate())
+	assert.EqualError(t, projectCatalog{}.Validate(), "the catalog has no project types")
+	assert.EqualError(t, projectCatalog{Types: []projectTypeEntry{
+		{Name: "api", Repo: goravelRepo},
+		{Name: "api", Repo: goravelLiteRepo},
+	}}.Validate(), `the project type "api" is declared twice`)
+	assert.EqualError(t, projectCatalog{Types: []projectTypeEntry{
+		{Name: "api", Repo: goravelRepo, MinInstallerVersion: "1.18"},
+	}}.Validate(), `invalid min_installer_version "1.18" of the project type "api", use a version such as v1.18.0`)
+}
+
+func TestProjectCatalogFind(t *testing.T) {
+	catalog := projectCatalog{Types: []projectTypeEntry{
+		{Name: "api", Repo: goravelRepo, MinInstallerVersion: "v1.0.0"},
+		{Name: "next", Repo: goravelRepo, MinInstallerVersion: "v99.0.0"},
+	}}
+
+	projectType, err := catalog.Find("api")
+	assert.Nil(t, err)
+	assert.Equal(t, "api", projectType.Name)
+
+	_, err = catalog.Find("next")
+	assert.EqualError(t, err, `the project type "next" requires the installer v99.0.0 or newer, run the upgrade command first`)
+
+	_, err = catalog.Find("web")
+	assert.EqualError(t, err, `invalid project type "web", use one of: api, next`)
+
+	assert.Equal(t, catalog.Types[:1], catalog.Supported())
+}
@@ -0,0 +1,122 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/goravel/framework/support/color"
+)
+
+type cleanupStep struct {
+	message string
+	undo    func() error
+}
+
+// projectCleanup records how to undo what the new command has written, so a failed or interrupted command doesn't
+// leave a partially created project behind. The steps are undone in the reverse order, the ones without a message
+// are undone silently. A nil cleanup records nothing.
+type projectCleanup struct {
+	mu        sync.Mutex
+	steps     []cleanupStep
+	committed bool
+	cleaned   bool
+}
+
+func newProjectCleanup() *projectCleanup {
+	return &projectCleanup{}
+}
+
+// Add Record how to undo a change. A change made after the cleanup, e.g. by a step that was still running when the
+// command was interrupted, is undone right away.
+func (r *projectCleanup) Add(message string, undo func() error) {
+	if r == nil {
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	switch {
+	case r.committed:
+	case r.cleaned:
+		_ = undo()
+	default:
+		r.steps = append(r.steps, cleanupStep{message: message, undo: undo})
+	}
+}
+
+// Commit Forget the recorded changes, the project is complete.
+func (r *projectCleanup) Commit() {
+	if r == nil {
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.committed = true
+	r.steps = nil
+}
+
+// Run Undo the recorded changes and print what has been cleaned up, it does nothing once the project is complete.
+// It reports whether there were changes to undo.
+func (r *projectCleanup) Run() bool {
+	if r == nil {
+		return false
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.committed || r.cleaned {
+		return false
+	}
+	r.cleaned = true
+	ran := len(r.steps) > 0
+
+	var messages []string
+	for i := len(r.steps) - 1; i >= 0; i-- {
+		step := r.steps[i]
+		if err := step.undo(); err != nil {
+			// There is nothing to undo when the change has been undone already, e.g. by the failed step
+			if !os.IsNotExist(err) {
+				color.Warnln(fmt.Sprintf("Failed to clean up the partially created project: %s", err))
+			}
+			continue
+		}
+		if step.message != "" {
+			messages = append(messages, step.message)
+		}
+	}
+	r.steps = nil
+
+	if len(messages) == 0 {
+		return ran
+	}
+
+	color.Warnln("Cleaned up the partially created project:")
+	for _, message := range messages {
+		color.Printfln("  %s", message)
+	}
+
+	return ran
+}
+
+// firstMissingDirectory Get the outermost directory of the path that doesn't exist yet, an empty string is returned
+// when the path exists.
+func firstMissingDirectory(path string) string {
+	var missing string
+	for !verifyIfDirectoryExists(path) {
+		missing = path
+
+		parent := filepath.Dir(path)
+		if parent == path {
+			break
+		}
+		path = parent
+	}
+
+	return missing
+}
@@ -0,0 +1,91 @@
+package commands
+
+import (
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/goravel/framework/support/color"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProjectCleanup(t *testing.T) {
+	t.Run("undoes the steps in the reverse order", func(t *testing.T) {
+		var undone []string
+		cleanup := newProjectCleanup()
+		cleanup.Add("Removed the staging directory", func() error {
+			undone = append(undone, "staging")
+			return nil
+		})
+		cleanup.Add("", func() error {
+			undone = append(undone, "silent")
+			return nil
+		})
+		cleanup.Add("Restored the blog from backup", func() error {
+			undone = append(undone, "backup")
+			return nil
+		})
+		cleanup.Add("Removed the blog", func() error {
+			return &os.PathError{Op: "remove", Path: "blog", Err: os.ErrNotExist}
+		})
+		cleanup.Add("Removed the docs", func() error {
+			return errors.New("permission denied")
+		})
+
+		captureOutput := color.CaptureOutput(func(w io.Writer) {
+			assert.True(t, cleanup.Run())
+			assert.False(t, cleanup.Run())
+		})
+		assert.Equal(t, []string{"backup", "silent", "staging"}, undone)
+		assert.Contains(t, captureOutput, "Failed to clean up the partially created project: permission denied")
+		assert.Contains(t, captureOutput, "Cleaned up the partially created project:")
+		assert.Contains(t, captureOutput, "  Restored the blog from backup\n  Removed the staging directory\n")
+		assert.NotContains(t, captureOutput, "Removed the blog")
+
+		// A change made by a step that was still running is undone right away
+		cleanup.Add("Removed the vendor directory", func() error {
+			undone = append(undone, "vendor")
+			return nil
+		})
+		assert.Equal(t, []string{"backup", "silent", "staging", "vendor"}, undone)
+	})
+
+	t.Run("does nothing once committed", func(t *testing.T) {
+		cleanup := newProjectCleanup()
+		cleanup.Add("Removed the staging directory", func() error {
+			t.Fatal("the step should not be undone")
+			return nil
+		})
+		cleanup.Commit()
+		cleanup.Add("Removed the blog", func() error {
+			t.Fatal("the step should not be undone")
+			return nil
+		})
+
+		assert.Empty(t, color.CaptureOutput(func(w io.Writer) {
+			assert.False(t, cleanup.Run())
+		}))
+	})
+
+	t.Run("nil cleanup", func(t *testing.T) {
+		var cleanup *projectCleanup
+		cleanup.Add("Removed the blog", func() error {
+			return nil
+		})
+		cleanup.Commit()
+		assert.False(t, cleanup.Run())
+	})
+
+	t.Run("nothing to undo", func(t *testing.T) {
+		assert.False(t, newProjectCleanup().Run())
+	})
+}
+
+func TestFirstMissingDirectory(t *testing.T) {
+	path := t.TempDir()
+
+	assert.Equal(t, "", firstMissingDirectory(path))
+	assert.Equal(t, filepath.Join(path, "services"), firstMissingDirectory(filepath.Join(path, "services", "billing", "api")))
+}
@@ -0,0 +1,184 @@
+package commands
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/goravel/framework/contracts/console"
+)
+
+// envKeyRegexp matches the keys that can be set with --env.
+var envKeyRegexp = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// The files the system timezone is read from, they can be replaced in tests.
+var (
+	timezoneFile  = "/etc/timezone"
+	localtimeFile = "/etc/localtime"
+)
+
+// envSetting describes a value of the .env file that is asked during the creation of a project.
+type envSetting struct {
+	Key      string
+	Question string
+	Default  string
+	// Detected is true when the default comes from the project or the system, it's set even when no question can be
+	// asked, the other settings keep the value of the template then.
+	Detected bool
+	Validate func(value string) error
+}
+
+// projectEnvSettings Get the .env settings of a new project, in the order they are asked.
+func projectEnvSettings(name string) []envSetting {
+	return []envSetting{
+		{Key: "APP_NAME", Question: "What is the application name?", Default: filepath.Base(getAbsolutePath(name)), Detected: true},
+		{Key: "APP_URL", Question: "What is the application URL?", Default: "http://localhost", Validate: validateEnvURL},
+		{Key: "APP_PORT", Question: "Which port does the application listen on?", Default: "3000", Validate: validateEnvPort},
+		{Key: "APP_TIMEZONE", Question: "What is the timezone of the application?", Default: detectTimezone(), Detected: true, Validate: validateEnvTimezone},
+		{Key: "APP_LOCALE", Question: "What is the locale of the application?", Default: "en"},
+	}
+}
+
+// getProjectEnv Get the values of the .env file of the project, they come from the --env options, the answers to the
+// prompts or the detected defaults when no question can be asked. The order of the settings is kept, the other
+// --env options follow in their order.
+func (r *NewCommand) getProjectEnv(ctx console.Context, name string, noInteraction bool) ([][2]string, error) {
+	options, err := parseEnvOptions(ctx.OptionSlice("env"))
+	if err != nil {
+		return nil, err
+	}
+
+	var values [][2]string
+	for _, setting := range projectEnvSettings(name) {
+		index := slices.IndexFunc(options, func(option [2]string) bool {
+			return option[0] == setting.Key
+		})
+		if index != -1 {
+			if setting.Validate != nil {
+				if err := setting.Validate(options[index][1]); err != nil {
+					return nil, fmt.Errorf("invalid --env %s: %s", setting.Key, err)
+				}
+			}
+
+			values = append(values, options[index])
+			options = slices.Delete(options, index, index+1)
+			continue
+		}
+
+		if noInteraction {
+			if setting.Detected {
+				values = append(values, [2]string{setting.Key, setting.Default})
+			}
+			continue
+		}
+
+		value, err := ctx.Ask(setting.Question, console.AskOption{
+			Default:  setting.Default,
+			Prompt:   "> ",
+			Validate: setting.Validate,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		values = append(values, [2]string{setting.Key, value})
+	}
+
+	return append(values, options...), nil
+}
+
+// parseEnvOptions Parse the --env KEY=VALUE options, a key set twice keeps its last value.
+func parseEnvOptions(options []string) ([][2]string, error) {
+	var values [][2]string
+	for _, option := range options {
+		key, value, ok := strings.Cut(option, "=")
+		if key = strings.TrimSpace(key); !ok || !envKeyRegexp.MatchString(key) {
+			return nil, fmt.Errorf("invalid --env %q, use KEY=VALUE", option)
+		}
+
+		values = slices.DeleteFunc(values, func(existing [2]string) bool {
+			return existing[0] == key
+		})
+		values = append(values, [2]string{key, value})
+	}
+
+	return values, nil
+}
+
+// configureEnv Set the values in the .env file of the project, the comments and the order of the keys are kept.
+func configureEnv(path string, values [][2]string) error {
+	formatted := make([][2]string, len(values))
+	for i, value := range values {
+		formatted[i] = [2]string{value[0], formatEnvValue(value[1])}
+	}
+
+	if err := setEnvValues(filepath.Join(path, ".env"), formatted); err != nil {
+		return fmt.Errorf("failed to update .env: %s", err)
+	}
+
+	return nil
+}
+
+// formatEnvValue Quote a value that can't be written as is, e.g. an application name with spaces.
+func formatEnvValue(value string) string {
+	if strings.ContainsAny(value, " \t#\"'\\") {
+		return strconv.Quote(value)
+	}
+
+	return value
+}
+
+// detectTimezone Get the IANA name of the system timezone from TZ, /etc/timezone or the /etc/localtime link, UTC is
+// returned when it can't be detected, e.g. on Windows.
+func detectTimezone() string {
+	candidates := []string{strings.TrimPrefix(os.Getenv("TZ"), ":")}
+	if content, err := os.ReadFile(timezoneFile); err == nil {
+		candidates = append(candidates, strings.TrimSpace(string(content)))
+	}
+	if link, err := filepath.EvalSymlinks(localtimeFile); err == nil {
+		if _, name, ok := strings.Cut(filepath.ToSlash(link), "zoneinfo/"); ok {
+			name = strings.TrimPrefix(strings.TrimPrefix(name, "posix/"), "right/")
+			candidates = append(candidates, name)
+		}
+	}
+
+	for _, candidate := range candidates {
+		if candidate != "" && validateEnvTimezone(candidate) == nil {
+			return candidate
+		}
+	}
+
+	return "UTC"
+}
+
+func validateEnvURL(value string) error {
+	parsed, err := url.Parse(value)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return fmt.Errorf("invalid URL %q, use an HTTP(S) URL such as http://localhost", value)
+	}
+
+	return nil
+}
+
+func validateEnvPort(value string) error {
+	if port, err := strconv.Atoi(value); err != nil || port < 1 || port > 65535 {
+		return fmt.Errorf("invalid port %q, use a number between 1 and 65535", value)
+	}
+
+	return nil
+}
+
+func validateEnvTimezone(value string) error {
+	// Local is accepted by LoadLocation, but it's not a timezone name
+	if _, err := time.LoadLocation(value); err != nil || value == "Local" {
+		return fmt.Errorf("invalid timezone %q, use an IANA name such as Europe/Paris", value)
+	}
+
+	return nil
+}
@@ -0,0 +1,117 @@
+package commands
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	mocksconsole "github.com/goravel/framework/mocks/console"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestGetProjectEnv(t *testing.T) {
+	newCommand := &NewCommand{}
+	t.Setenv("TZ", "Asia/Shanghai")
+
+	t.Run("no interaction", func(t *testing.T) {
+		mockContext := mocksconsole.NewContext(t)
+		mockContext.EXPECT().OptionSlice("env").Return([]string{"MAIL_HOST=smtp.example.com", "APP_LOCALE=fr"}).Once()
+
+		env, err := newCommand.getProjectEnv(mockContext, "services/blog", true)
+		assert.Nil(t, err)
+		assert.Equal(t, [][2]string{
+			{"APP_NAME", "blog"},
+			{"APP_TIMEZONE", "Asia/Shanghai"},
+			{"APP_LOCALE", "fr"},
+			{"MAIL_HOST", "smtp.example.com"},
+		}, env)
+	})
+
+	t.Run("asks the values that are not passed", func(t *testing.T) {
+		mockContext := mocksconsole.NewContext(t)
+		mockContext.EXPECT().OptionSlice("env").Return([]string{"APP_NAME=Blog", "APP_PORT=8080"}).Once()
+		mockContext.EXPECT().Ask("What is the application URL?", mock.Anything).Return("https://blog.test", nil).Once()
+		mockContext.EXPECT().Ask("What is the timezone of the application?", mock.Anything).Return("Asia/Shanghai", nil).Once()
+		mockContext.EXPECT().Ask("What is the locale of the application?", mock.Anything).Return("en", nil).Once()
+
+		env, err := newCommand.getProjectEnv(mockContext, "blog", false)
+		assert.Nil(t, err)
+		assert.Equal(t, [][2]string{
+			{"APP_NAME", "Blog"},
+			{"APP_URL", "https://blog.test"},
+			{"APP_PORT", "8080"},
+			{"APP_TIMEZONE", "Asia/Shanghai"},
+			{"APP_LOCALE", "en"},
+		}, env)
+	})
+
+	t.Run("invalid value", func(t *testing.T) {
+		mockContext := mocksconsole.NewContext(t)
+		mockContext.EXPECT().OptionSlice("env").Return([]string{"APP_PORT=http"}).Once()
+
+		_, err := newCommand.getProjectEnv(mockContext, "blog", true)
+		assert.EqualError(t, err, `invalid --env APP_PORT: invalid port "http", use a number between 1 and 65535`)
+	})
+}
+
+func TestParseEnvOptions(t *testing.T) {
+	values, err := parseEnvOptions([]string{"APP_URL=http://localhost", "APP_KEY=", "APP_URL=https://blog.test"})
+	assert.Nil(t, err)
+	assert.Equal(t, [][2]string{{"APP_KEY", ""}, {"APP_URL", "https://blog.test"}}, values)
+
+	_, err = parseEnvOptions([]string{"APP URL=http://localhost"})
+	assert.EqualError(t, err, `invalid --env "APP URL=http://localhost", use KEY=VALUE`)
+
+	_, err = parseEnvOptions([]string{"APP_URL"})
+	assert.EqualError(t, err, `invalid --env "APP_URL", use KEY=VALUE`)
+}
+
+func TestConfigureEnv(t *testing.T) {
+	path := t.TempDir()
+	writeFile(t, filepath.Join(path, ".env"), "# Application\nAPP_NAME=Goravel\nAPP_ENV=local\n\n# HTTP\nAPP_URL=http://localhost\nAPP_PORT=3000 # the port of the HTTP server\n")
+
+	assert.Nil(t, configureEnv(path, [][2]string{
+		{"APP_NAME", "My Blog"},
+		{"APP_PORT", "8080"},
+		{"APP_TIMEZONE", "Europe/Paris"},
+	}))
+
+	content, err := os.ReadFile(filepath.Join(path, ".env"))
+	assert.Nil(t, err)
+	assert.Equal(t, "# Application\nAPP_NAME=\"My Blog\"\nAPP_ENV=local\n\n# HTTP\nAPP_URL=http://localhost\nAPP_PORT=8080 # the port of the HTTP server\nAPP_TIMEZONE=Europe/Paris\n", string(content))
+}
+
+func TestDetectTimezone(t *testing.T) {
+	dir := t.TempDir()
+	timezoneFile, localtimeFile = filepath.Join(dir, "timezone"), filepath.Join(dir, "localtime")
+	t.Cleanup(func() {
+		timezoneFile, localtimeFile = "/etc/timezone", "/etc/localtime"
+	})
+
+	t.Setenv("TZ", "")
+	assert.Equal(t, "UTC", detectTimezone())
+
+	zoneinfo := filepath.Join(dir, "zoneinfo", "America", "New_York")
+	writeFile(t, zoneinfo, "")
+	assert.Nil(t, os.Symlink(zoneinfo, localtimeFile))
+	assert.Equal(t, "America/New_York", detectTimezone())
+
+	writeFile(t, timezoneFile, "Europe/Paris\n")
+	assert.Equal(t, "Europe/Paris", detectTimezone())
+
+	t.Setenv("TZ", ":Asia/Tokyo")
+	assert.Equal(t, "Asia/Tokyo", detectTimezone())
+
+	t.Setenv("TZ", "Mars/Olympus")
+	assert.Equal(t, "Europe/Paris", detectTimezone())
+}
+
+func TestValidateEnvSettings(t *testing.T) {
+	assert.Nil(t, validateEnvURL("https://blog.test"))
+	assert.EqualError(t, validateEnvURL("blog.test"), `invalid URL "blog.test", use an HTTP(S) URL such as http://localhost`)
+	assert.Nil(t, validateEnvPort("8080"))
+	assert.NotNil(t, validateEnvPort("70000"))
+	assert.Nil(t, validateEnvTimezone("Europe/Paris"))
+	assert.EqualError(t, validateEnvTimezone("Local"), `invalid timezone "Local", use an IANA name such as Europe/Paris`)
+}
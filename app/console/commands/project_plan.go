@@ -0,0 +1,147 @@
+package commands
+
+import (
+	"fmt"
+	"io/fs"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/goravel/framework/support/color"
+)
+
+// projectPlan records the steps of a dry run. The project is built in a scratch directory, so the file changes are
+// real, but the commands are only recorded. A nil plan runs every command.
+type projectPlan struct {
+	steps    []string
+	template map[string]string
+}
+
+func newProjectPlan() *projectPlan {
+	return &projectPlan{}
+}
+
+// Add Record a step that has been done in the scratch directory.
+func (r *projectPlan) Add(step string) {
+	if r != nil {
+		r.steps = append(r.steps, step)
+	}
+}
+
+// Run Run the command, or only record it during a dry run.
+func (r *projectPlan) Run(command string, action func() error) error {
+	if r == nil {
+		return action()
+	}
+
+	r.steps = append(r.steps, "Run `"+command+"`")
+
+	return nil
+}
+
+// SnapshotTemplate Remember the files of the fetched template, Print compares the project with them.
+func (r *projectPlan) SnapshotTemplate(path string) error {
+	if r == nil {
+		return nil
+	}
+
+	var err error
+	r.template, err = snapshotFiles(path)
+
+	return err
+}
+
+// Print Print the steps and the files of the template the project adds, modifies or removes.
+func (r *projectPlan) Print(path string) error {
+	project, err := snapshotFiles(path)
+	if err != nil {
+		return err
+	}
+
+	color.Warnln("Dry run, nothing has been written, the plan is:")
+	for i, step := range r.steps {
+		color.Printfln("%d. %s", i+1, step)
+	}
+
+	added, modified, removed := diffFileSnapshots(r.template, project)
+	color.Green().Printfln("Files compared with the template: %d added, %d modified, %d removed", len(added), len(modified), len(removed))
+	for _, change := range []struct {
+		prefix string
+		files  []string
+	}{{"+", added}, {"~", modified}, {"-", removed}} {
+		for _, file := range change.files {
+			color.Printfln("  %s %s", change.prefix, file)
+		}
+	}
+
+	return nil
+}
+
+// formatCommand Format a command for the plan, the arguments that contain spaces are quoted.
+func formatCommand(name string, args ...string) string {
+	parts := []string{name}
+	for _, arg := range args {
+		if strings.ContainsAny(arg, " \t\"'") {
+			arg = strconv.Quote(arg)
+		}
+		parts = append(parts, arg)
+	}
+
+	return strings.Join(parts, " ")
+}
+
+// snapshotFiles Get the SHA-256 checksum of every file in the path, the .git directory is skipped.
+func snapshotFiles(path string) (map[string]string, error) {
+	snapshot := make(map[string]string)
+	err := filepath.WalkDir(path, func(filePath string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if entry.IsDir() {
+			if entry.Name() == ".git" {
+				return filepath.SkipDir
+			}
+
+			return nil
+		}
+
+		checksum, err := fileChecksum(filePath)
+		if err != nil {
+			return err
+		}
+		relativePath, err := filepath.Rel(path, filePath)
+		if err != nil {
+			return err
+		}
+		snapshot[filepath.ToSlash(relativePath)] = checksum
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %s", path, err)
+	}
+
+	return snapshot, nil
+}
+
+func diffFileSnapshots(before, after map[string]string) (added, modified, removed []string) {
+	for file, checksum := range after {
+		if beforeChecksum, ok := before[file]; !ok {
+			added = append(added, file)
+		} else if beforeChecksum != checksum {
+			modified = append(modified, file)
+		}
+	}
+	for file := range before {
+		if _, ok := after[file]; !ok {
+			removed = append(removed, file)
+		}
+	}
+
+	slices.Sort(added)
+	slices.Sort(modified)
+	slices.Sort(removed)
+
+	return added, modified, removed
+}
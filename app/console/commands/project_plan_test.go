@@ -0,0 +1,30 @@
+package commands
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiffFileSnapshots(t *testing.T) {
+	before := map[string]string{"go.mod": "a1", "main.go": "b2", "app/examples/example.go": "c3", "README.md": "d4"}
+	after := map[string]string{"go.mod": "a2", "main.go": "b2", "README.md": "d5", ".env": "e1", "config/app.go": "f1"}
+
+	added, modified, removed := diffFileSnapshots(before, after)
+	assert.Equal(t, []string{".env", "config/app.go"}, added)
+	assert.Equal(t, []string{"README.md", "go.mod"}, modified)
+	assert.Equal(t, []string{"app/examples/example.go"}, removed)
+
+	added, modified, removed = diffFileSnapshots(nil, map[string]string{"go.mod": "a1"})
+	assert.Equal(t, []string{"go.mod"}, added)
+	assert.Nil(t, modified)
+	assert.Nil(t, removed)
+}
+
+func TestFormatCommand(t *testing.T) {
+	assert.Equal(t, "go mod tidy", formatCommand("go", "mod", "tidy"))
+	assert.Equal(t, "git", formatCommand("git"))
+	assert.Equal(t, `git commit -m "Initial commit"`, formatCommand("git", "commit", "-m", "Initial commit"))
+	assert.Equal(t, `sh -c "echo \"it's\""`, formatCommand("sh", "-c", `echo "it's"`))
+	assert.Equal(t, `echo "a\tb"`, formatCommand("echo", "a\tb"))
+}
@@ -1,6 +1,7 @@
 package commands
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"os"
@@ -57,24 +58,75 @@ func (r *SkillInstallCommand) Extend() command.Extend {
 				Usage:              "Overwrite existing skills",
 				DisableDefaultText: true,
 			},
+			&command.StringFlag{
+				Name:  "transport",
+				Usage: "How to fetch the skills: git or archive. Defaults to git, or archive when git is not installed",
+			},
+			&command.StringFlag{
+				Name:  "mirror",
+				Usage: "The comma-separated mirrors to clone the skills from: github, gitee or an HTTP(S) base URL, the next one is tried when a clone fails",
+			},
+			&command.StringFlag{
+				Name:  "format",
+				Usage: "The output format: text or json, json prints newline-delimited events instead of colored text",
+				Value: outputFormatText,
+			},
 		},
 	}
 }
 
 // Handle Execute the console command.
 func (r *SkillInstallCommand) Handle(ctx console.Context) error {
-	destination, err := r.getDestination(ctx)
+	events, err := newEventStream(ctx.Option("format"))
 	if err != nil {
 		color.Errorln(err)
 		return nil
 	}
 
-	installed, skipped, err := r.installSkills(destination, ctx.ArgumentStringSlice("skills"), ctx.OptionBool("force"))
+	return events.Silence(func() error {
+		return r.handle(ctx, events)
+	})
+}
+
+func (r *SkillInstallCommand) handle(ctx console.Context, events *eventStream) error {
+	config, err := loadInstallerConfig()
 	if err != nil {
-		color.Errorln(err)
-		return nil
+		return events.Error(err)
+	}
+	ctx = newConfigContext(ctx, config, map[string]string{
+		"path": "skill.path",
+	})
+
+	destination, err := r.getDestination(ctx)
+	if err != nil {
+		return events.Error(err)
+	}
+
+	transport, err := resolveTransport(ctx.Option("transport"))
+	if err != nil {
+		return events.Error(err)
+	}
+
+	mirrors, err := getMirrors(ctx.Option("mirror"), config)
+	if err != nil {
+		return events.Error(err)
+	}
+
+	var installed, skipped int
+	if err := events.Step("install_skills", "Install Goravel skills to "+destination, func() error {
+		var err error
+		installed, skipped, err = r.installSkills(ctx, destination, ctx.ArgumentStringSlice("skills"), ctx.OptionBool("force"), transport, mirrors)
+
+		return err
+	}); err != nil {
+		return events.Error(err)
 	}
 
+	events.Set("path", destination)
+	events.Set("installed", installed)
+	events.Set("skipped", skipped)
+	events.Summary()
+
 	if installed > 0 {
 		color.Successf("Installed %d Goravel skill(s) to %s\n", installed, destination)
 	}
@@ -110,7 +162,7 @@ func (r *SkillInstallCommand) getDestination(ctx console.Context) (string, error
 	return destination, nil
 }
 
-func (r *SkillInstallCommand) installSkills(destination string, skillNames []string, force bool) (int, int, error) {
+func (r *SkillInstallCommand) installSkills(ctx context.Context, destination string, skillNames []string, force bool, transport string, mirrors []string) (int, int, error) {
 	tmpDir, err := os.MkdirTemp("", "goravel-agents-*")
 	if err != nil {
 		return 0, 0, fmt.Errorf("failed to create temp directory: %w", err)
@@ -120,7 +172,7 @@ func (r *SkillInstallCommand) installSkills(destination string, skillNames []str
 	}()
 
 	repoPath := filepath.Join(tmpDir, "agents")
-	if err := cloneAgents(repoPath); err != nil {
+	if err := cloneAgents(ctx, repoPath, transport, mirrors); err != nil {
 		return 0, 0, err
 	}
 
@@ -153,10 +205,37 @@ func (r *SkillInstallCommand) installSkills(destination string, skillNames []str
 	return installed, skipped, nil
 }
 
-func cloneAgents(path string) error {
-	res := facades.Process().Quietly().WithSpinner("Downloading Goravel agents").Run("git", "clone", "--depth=1", agentsRepo, path)
-	if res.Failed() {
-		return fmt.Errorf("failed to clone goravel agents: %v", res.Error())
+func cloneAgents(ctx context.Context, path, transport string, mirrors []string) error {
+	if transport == transportArchive {
+		return downloadAgents(ctx, path, mirrors)
+	}
+
+	return cloneFromMirrors(agentsRepo, mirrors, path, func(repo string, fallback bool) error {
+		res := abortStalledClone(facades.Process(), fallback).Quietly().WithSpinner("Downloading Goravel agents").Run("git", "clone", "--depth=1", repo, path)
+		if res.Failed() {
+			return fmt.Errorf("failed to clone goravel agents: %v", res.Error())
+		}
+
+		return nil
+	})
+}
+
+func downloadAgents(ctx context.Context, path string, mirrors []string) error {
+	url, err := archiveURL(agentsRepo, "", mirrors)
+	if err != nil {
+		return err
+	}
+
+	archive, err := downloadArchive(ctx, url, "")
+	if err != nil {
+		return fmt.Errorf("failed to download goravel agents: %w", err)
+	}
+	defer func() {
+		_ = os.Remove(archive)
+	}()
+
+	if err := unpackArchive(archive, path); err != nil {
+		return fmt.Errorf("failed to extract goravel agents: %w", err)
 	}
 
 	return nil
@@ -103,6 +103,51 @@ func (s *SkillInstallCommandTestSuite) TestHandleInstallSelected() {
 	s.NoFileExists(filepath.Join(destination, "goravel-planning", "SKILL.md"))
 }
 
+func (s *SkillInstallCommandTestSuite) TestHandleInstallConfiguredPath() {
+	isolateUserDirs(s.T())
+	s.T().Chdir(s.T().TempDir())
+	mockProcess := frameworkmock.Factory().Process()
+	destination := filepath.Join(s.T().TempDir(), "configured-skills")
+
+	userPath, err := userInstallerConfigPath()
+	s.NoError(err)
+	writeFile(s.T(), userPath, "skill:\n  path: "+destination+"\n")
+	expectAgentsClone(s.T(), mockProcess, map[string]string{
+		"goravel-testing": "testing skill",
+	})
+
+	mockContext := newSkillInstallContext(s.T(), "", nil, false)
+	captureOutput := color.CaptureOutput(func(w io.Writer) {
+		s.NoError(s.skillInstallCommand.Handle(mockContext))
+	})
+
+	s.Contains(captureOutput, "Installed 1 Goravel skill(s) to "+destination)
+	s.Equal("testing skill", readSkillContent(s.T(), destination, "goravel-testing"))
+}
+
+func (s *SkillInstallCommandTestSuite) TestHandleInstallWithoutGit() {
+	destination := filepath.Join(s.T().TempDir(), "skills")
+	archive := filepath.Join(s.T().TempDir(), "agents.tar.gz")
+	writeTarball(s.T(), archive, map[string]string{
+		"agents-master/skills/goravel-testing/SKILL.md": "testing skill",
+	})
+	content, err := os.ReadFile(archive)
+	s.NoError(err)
+	serveArchives(s.T(), map[string][]byte{"/goravel/agents/archive/HEAD.tar.gz": content})
+
+	mockContext := newSkillInstallContext(s.T(), destination, nil, false)
+	// The download is bound to the context of the command
+	mockContext.EXPECT().Done().Return(nil).Maybe()
+	mockContext.EXPECT().Value(mock.Anything).Return(nil).Maybe()
+	setGitInstalled(s.T(), false)
+	captureOutput := color.CaptureOutput(func(w io.Writer) {
+		s.NoError(s.skillInstallCommand.Handle(mockContext))
+	})
+
+	s.Contains(captureOutput, "Installed 1 Goravel skill(s)")
+	s.Equal("testing skill", readSkillContent(s.T(), destination, "goravel-testing"))
+}
+
 func (s *SkillInstallCommandTestSuite) TestHandleMissingSkill() {
 	mockProcess := frameworkmock.Factory().Process()
 	destination := filepath.Join(s.T().TempDir(), "skills")
@@ -180,8 +225,12 @@ func (s *SkillInstallCommandTestSuite) TestHandleCloneFailure() {
 func newSkillInstallContext(t *testing.T, destination string, skills []string, force bool) *mocksconsole.Context {
 	t.Helper()
 
+	setGitInstalled(t, true)
 	mockContext := mocksconsole.NewContext(t)
+	mockContext.EXPECT().Option("format").Return("").Once()
 	mockContext.EXPECT().Option("path").Return(destination).Once()
+	mockContext.EXPECT().Option("transport").Return("").Once()
+	mockContext.EXPECT().Option("mirror").Return("").Once()
 	mockContext.EXPECT().ArgumentStringSlice("skills").Return(skills).Once()
 	mockContext.EXPECT().OptionBool("force").Return(force).Once()
 
@@ -1,6 +1,7 @@
 package commands
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"os"
@@ -15,8 +16,8 @@ import (
 type SkillListCommand struct{}
 
 type skillDetail struct {
-	Name        string
-	Description string
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
 }
 
 func NewSkillListCommand() *SkillListCommand {
@@ -43,19 +44,65 @@ func (r *SkillListCommand) Extend() command.Extend {
 				Usage:              "Print skill details",
 				DisableDefaultText: true,
 			},
+			&command.StringFlag{
+				Name:  "transport",
+				Usage: "How to fetch the skills: git or archive. Defaults to git, or archive when git is not installed",
+			},
+			&command.StringFlag{
+				Name:  "mirror",
+				Usage: "The comma-separated mirrors to clone the skills from: github, gitee or an HTTP(S) base URL, the next one is tried when a clone fails",
+			},
+			&command.StringFlag{
+				Name:  "format",
+				Usage: "The output format: text or json, json prints newline-delimited events instead of colored text",
+				Value: outputFormatText,
+			},
 		},
 	}
 }
 
 // Handle Execute the console command.
 func (r *SkillListCommand) Handle(ctx console.Context) error {
-	detail := ctx.OptionBool("detail")
-	skills, err := r.fetchSkills(detail)
+	events, err := newEventStream(ctx.Option("format"))
 	if err != nil {
 		color.Errorln(err)
 		return nil
 	}
 
+	return events.Silence(func() error {
+		return r.handle(ctx, events)
+	})
+}
+
+func (r *SkillListCommand) handle(ctx console.Context, events *eventStream) error {
+	detail := ctx.OptionBool("detail")
+	transport, err := resolveTransport(ctx.Option("transport"))
+	if err != nil {
+		return events.Error(err)
+	}
+
+	config, err := loadInstallerConfig()
+	if err != nil {
+		return events.Error(err)
+	}
+	mirrors, err := getMirrors(ctx.Option("mirror"), config)
+	if err != nil {
+		return events.Error(err)
+	}
+
+	var skills []skillDetail
+	if err := events.Step("fetch_skills", "Fetch the Goravel skills", func() error {
+		var err error
+		skills, err = r.fetchSkills(ctx, detail, transport, mirrors)
+
+		return err
+	}); err != nil {
+		return events.Error(err)
+	}
+
+	events.Set("skills", skills)
+	events.Summary()
+
 	color.Green().Printfln("Available Goravel skills:")
 	for index, skill := range skills {
 		if detail {
@@ -71,7 +118,7 @@ func (r *SkillListCommand) Handle(ctx console.Context) error {
 	return nil
 }
 
-func (r *SkillListCommand) fetchSkills(detail bool) ([]skillDetail, error) {
+func (r *SkillListCommand) fetchSkills(ctx context.Context, detail bool, transport string, mirrors []string) ([]skillDetail, error) {
 	tmpDir, err := os.MkdirTemp("", "goravel-agents-*")
 	if err != nil {
 		return nil, fmt.Errorf("failed to create temp directory: %w", err)
@@ -81,7 +128,7 @@ func (r *SkillListCommand) fetchSkills(detail bool) ([]skillDetail, error) {
 	}()
 
 	repoPath := filepath.Join(tmpDir, "agents")
-	if err := cloneAgents(repoPath); err != nil {
+	if err := cloneAgents(ctx, repoPath, transport, mirrors); err != nil {
 		return nil, err
 	}
 
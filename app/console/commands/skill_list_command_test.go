@@ -90,11 +90,42 @@ func (s *SkillListCommandTestSuite) TestHandleCloneFailure() {
 	s.Contains(captureOutput, "failed to clone goravel agents: clone failed")
 }
 
+func (s *SkillListCommandTestSuite) TestHandleJSON() {
+	mockProcess := frameworkmock.Factory().Process()
+	expectAgentsClone(s.T(), mockProcess, map[string]string{
+		"goravel-planning": "planning skill",
+		"goravel-testing":  "testing skill",
+	})
+	output := setEventOutput(s.T())
+
+	setGitInstalled(s.T(), true)
+	mockContext := mocksconsole.NewContext(s.T())
+	mockContext.EXPECT().Option("format").Return("json").Once()
+	mockContext.EXPECT().OptionBool("detail").Return(false).Once()
+	mockContext.EXPECT().Option("transport").Return("").Once()
+	mockContext.EXPECT().Option("mirror").Return("").Once()
+
+	s.NoError(s.skillListCommand.Handle(mockContext))
+
+	events := readEvents(s.T(), output)
+	s.Require().Len(events, 3)
+	s.Equal("step_started", events[0]["event"])
+	s.Equal("fetch_skills", events[0]["step"])
+	s.Equal("step_finished", events[1]["event"])
+	s.Equal("succeeded", events[1]["status"])
+	s.Equal("summary", events[2]["event"])
+	s.Equal([]any{map[string]any{"name": "goravel-planning"}, map[string]any{"name": "goravel-testing"}}, events[2]["skills"])
+}
+
 func newSkillListContext(t *testing.T, detail bool) *mocksconsole.Context {
 	t.Helper()
 
+	setGitInstalled(t, true)
 	mockContext := mocksconsole.NewContext(t)
+	mockContext.EXPECT().Option("format").Return("").Once()
 	mockContext.EXPECT().OptionBool("detail").Return(detail).Once()
+	mockContext.EXPECT().Option("transport").Return("").Once()
+	mockContext.EXPECT().Option("mirror").Return("").Once()
 
 	return mockContext
 }
@@ -0,0 +1,152 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/goravel/framework/errors"
+	"github.com/goravel/framework/support/color"
+	"github.com/goravel/framework/support/file"
+)
+
+const (
+	templateKindGit       = "git"
+	templateKindDirectory = "directory"
+	templateKindArchive   = "archive"
+)
+
+var scpLikeGitURLRegexp = regexp.MustCompile(`^[\w.-]+@[\w.-]+:`)
+
+// templateFetchOptions describes how a template is fetched.
+type templateFetchOptions struct {
+	Checksum  string
+	Mirrors   []string
+	Offline   bool
+	Ref       string
+	Transport string
+}
+
+// templateSource describes where the starter skeleton of a new project comes from.
+type templateSource struct {
+	Kind     string
+	Location string
+	// Ref is the ref installed when none is requested, e.g. the default ref of a project type of the catalog.
+	Ref string
+}
+
+// parseTemplateSource Resolve the --template value to a git repository, a local directory or an archive.
+func parseTemplateSource(template string) (templateSource, error) {
+	template = strings.TrimSpace(template)
+	if template == "" {
+		return templateSource{}, errors.New("the template is required")
+	}
+
+	if isHTTPURL(template) && isArchive(template) {
+		return templateSource{Kind: templateKindArchive, Location: template}, nil
+	}
+	if strings.Contains(template, "://") || scpLikeGitURLRegexp.MatchString(template) {
+		return templateSource{Kind: templateKindGit, Location: template}, nil
+	}
+
+	path, err := expandHomePath(template)
+	if err != nil {
+		return templateSource{}, err
+	}
+	path, err = filepath.Abs(path)
+	if err != nil {
+		return templateSource{}, fmt.Errorf("failed to resolve template path: %w", err)
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return templateSource{}, fmt.Errorf("template %q does not exist", template)
+		}
+
+		return templateSource{}, fmt.Errorf("failed to inspect template %q: %w", template, err)
+	}
+	if info.IsDir() {
+		return templateSource{Kind: templateKindDirectory, Location: path}, nil
+	}
+	if isArchive(path) {
+		return templateSource{Kind: templateKindArchive, Location: path}, nil
+	}
+
+	return templateSource{}, fmt.Errorf("unsupported template %q, use a git URL, a directory or a .tar.gz/.tgz/.tar/.zip archive", template)
+}
+
+// copyTemplateDirectory Copy a local template directory to the project path.
+func copyTemplateDirectory(source, path string) error {
+	if err := copyTemplate(source, path); err != nil {
+		return fmt.Errorf("failed to copy template: %w", err)
+	}
+
+	color.Successln("Copied template in " + path)
+
+	return nil
+}
+
+// copyTemplate Copy a template to the target, skipping its .git directory.
+func copyTemplate(source, target string) error {
+	if err := os.MkdirAll(target, 0755); err != nil {
+		return err
+	}
+
+	entries, err := os.ReadDir(source)
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		if entry.Name() == ".git" {
+			continue
+		}
+
+		sourcePath := filepath.Join(source, entry.Name())
+		targetPath := filepath.Join(target, entry.Name())
+		if entry.IsDir() {
+			if err := copyDirectory(sourcePath, targetPath); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if err := file.Copy(sourcePath, targetPath); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// extractTemplateArchive Extract a template archive, a local file or an HTTP(S) URL, to the project path.
+// The archive is verified against the SHA-256 checksum when one is given.
+func extractTemplateArchive(ctx context.Context, archive, checksum, path string) error {
+	if isHTTPURL(archive) {
+		downloaded, err := downloadArchive(ctx, archive, checksum)
+		if err != nil {
+			return fmt.Errorf("failed to download template: %w", err)
+		}
+		defer func() {
+			_ = os.Remove(downloaded)
+		}()
+
+		archive = downloaded
+	} else if checksum != "" {
+		if err := verifyFileChecksum(archive, checksum); err != nil {
+			return fmt.Errorf("failed to verify template: %w", err)
+		}
+	}
+
+	if err := unpackArchive(archive, path); err != nil {
+		return fmt.Errorf("failed to extract template: %w", err)
+	}
+
+	color.Successln("Extracted template in " + path)
+
+	return nil
+}
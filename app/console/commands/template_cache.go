@@ -0,0 +1,133 @@
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/goravel/framework/support/color"
+	"github.com/goravel/framework/support/file"
+)
+
+const defaultTemplateRef = "default"
+
+var templateCacheKeyRegexp = regexp.MustCompile(`[^\w.-]+`)
+
+// templateCacheMetadata describes a cached template.
+type templateCacheMetadata struct {
+	Repo     string    `json:"repo"`
+	Ref      string    `json:"ref"`
+	CachedAt time.Time `json:"cached_at"`
+}
+
+// templateCacheDir Get the directory of the template cache, e.g. ~/.cache/goravel/templates.
+func templateCacheDir() (string, error) {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to get user cache directory: %w", err)
+	}
+
+	return filepath.Join(dir, "goravel", "templates"), nil
+}
+
+// templateCachePath Get the cache entry of a repository at a ref, an empty ref means the default branch.
+func templateCachePath(repo, ref string) (string, error) {
+	dir, err := templateCacheDir()
+	if err != nil {
+		return "", err
+	}
+	if ref == "" {
+		ref = defaultTemplateRef
+	}
+
+	key := strings.TrimSuffix(repo, ".git")
+	if _, after, found := strings.Cut(key, "://"); found {
+		key = after
+	}
+	key = strings.Trim(templateCacheKeyRegexp.ReplaceAllString(key, "-"), "-")
+
+	return filepath.Join(dir, key, templateCacheKeyRegexp.ReplaceAllString(ref, "-")), nil
+}
+
+// readTemplateCache Get the metadata of a cached template, returns false when the template is not cached.
+func readTemplateCache(repo, ref string) (templateCacheMetadata, bool) {
+	var metadata templateCacheMetadata
+
+	path, err := templateCachePath(repo, ref)
+	if err != nil {
+		return metadata, false
+	}
+
+	content, err := os.ReadFile(filepath.Join(path, "metadata.json"))
+	if err != nil || json.Unmarshal(content, &metadata) != nil {
+		return metadata, false
+	}
+
+	return metadata, file.Exists(filepath.Join(path, "template"))
+}
+
+// saveTemplateCache Replace the cached template of a repository at a ref with the freshly cloned one.
+func saveTemplateCache(repo, ref, source string) error {
+	path, err := templateCachePath(repo, ref)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+
+	tmpPath, err := os.MkdirTemp(filepath.Dir(path), ".tmp-*")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = os.RemoveAll(tmpPath)
+	}()
+
+	if err := copyTemplate(source, filepath.Join(tmpPath, "template")); err != nil {
+		return err
+	}
+
+	content, err := json.Marshal(templateCacheMetadata{Repo: repo, Ref: ref, CachedAt: time.Now()})
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(filepath.Join(tmpPath, "metadata.json"), content, 0644); err != nil {
+		return err
+	}
+
+	if err := os.RemoveAll(path); err != nil {
+		return err
+	}
+
+	return os.Rename(tmpPath, path)
+}
+
+// restoreTemplateCache Create the project from the cached template of a repository at a ref.
+func restoreTemplateCache(repo, ref, path string) error {
+	metadata, ok := readTemplateCache(repo, ref)
+	if !ok {
+		if ref == "" {
+			ref = defaultTemplateRef
+		}
+
+		return fmt.Errorf("no cached template found for %s (%s), run the command once without --offline to cache it", repo, ref)
+	}
+
+	cachePath, err := templateCachePath(repo, ref)
+	if err != nil {
+		return err
+	}
+
+	if err := copyTemplate(filepath.Join(cachePath, "template"), path); err != nil {
+		return fmt.Errorf("failed to copy cached template: %w", err)
+	}
+
+	color.Successln(fmt.Sprintf("Created project from the template cached at %s", metadata.CachedAt.Format(time.DateTime)))
+
+	return nil
+}
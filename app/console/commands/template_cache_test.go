@@ -0,0 +1,98 @@
+package commands
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	mocksprocess "github.com/goravel/framework/mocks/process"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTemplateCachePath(t *testing.T) {
+	home := isolateUserDirs(t)
+
+	path, err := templateCachePath("https://github.com/goravel/goravel-lite.git", "")
+	assert.Nil(t, err)
+	assert.Equal(t, filepath.Join(home, ".cache", "goravel", "templates", "github.com-goravel-goravel-lite", "default"), path)
+
+	path, err = templateCachePath("git@github.com:goravel/goravel.git", "v1.16.0")
+	assert.Nil(t, err)
+	assert.Equal(t, filepath.Join(home, ".cache", "goravel", "templates", "git-github.com-goravel-goravel", "v1.16.0"), path)
+}
+
+func TestSaveAndRestoreTemplateCache(t *testing.T) {
+	isolateUserDirs(t)
+	repo := "https://github.com/goravel/goravel.git"
+
+	source := t.TempDir()
+	writeFile(t, filepath.Join(source, "go.mod"), "module goravel\n")
+	writeFile(t, filepath.Join(source, ".git", "HEAD"), "ref: refs/heads/master")
+
+	_, ok := readTemplateCache(repo, "")
+	assert.False(t, ok)
+
+	assert.Nil(t, saveTemplateCache(repo, "", source))
+	metadata, ok := readTemplateCache(repo, "")
+	assert.True(t, ok)
+	assert.Equal(t, repo, metadata.Repo)
+	assert.False(t, metadata.CachedAt.IsZero())
+
+	path := filepath.Join(t.TempDir(), "project")
+	assert.Nil(t, restoreTemplateCache(repo, "", path))
+	content, err := os.ReadFile(filepath.Join(path, "go.mod"))
+	assert.Nil(t, err)
+	assert.Equal(t, "module goravel\n", string(content))
+	assert.NoDirExists(t, filepath.Join(path, ".git"))
+
+	err = restoreTemplateCache(repo, "master", filepath.Join(t.TempDir(), "project"))
+	assert.ErrorContains(t, err, "no cached template found for "+repo+" (master)")
+}
+
+func TestFetchTemplateFromCache(t *testing.T) {
+	newCommand := &NewCommand{}
+	repo := "https://github.com/goravel/goravel.git"
+	source := templateSource{Kind: templateKindGit, Location: repo}
+
+	t.Run("offline", func(t *testing.T) {
+		isolateUserDirs(t)
+		cached := t.TempDir()
+		writeFile(t, filepath.Join(cached, "go.mod"), "module goravel\n")
+		assert.Nil(t, saveTemplateCache(repo, "master", cached))
+
+		path := filepath.Join(t.TempDir(), "project")
+		assert.Nil(t, newCommand.fetchTemplate(context.Background(), source, path, templateFetchOptions{Offline: true, Ref: "master"}))
+		assert.FileExists(t, filepath.Join(path, "go.mod"))
+	})
+
+	t.Run("falls back to the cache when the clone fails", func(t *testing.T) {
+		isolateUserDirs(t)
+		cached := t.TempDir()
+		writeFile(t, filepath.Join(cached, "go.mod"), "module goravel\n")
+		assert.Nil(t, saveTemplateCache(repo, "", cached))
+
+		mockProcess := mockNewProcess()
+		mockResult := mocksprocess.NewResult(t)
+		mockResult.EXPECT().Failed().Return(true).Once()
+		mockResult.EXPECT().Error().Return(assert.AnError).Once()
+		mockProcess.EXPECT().Run("git", "clone", "--depth=1", repo, "project-path").Return(mockResult).Once()
+
+		t.Chdir(t.TempDir())
+		assert.Nil(t, newCommand.fetchTemplate(context.Background(), source, "project-path", templateFetchOptions{Transport: transportGit}))
+		assert.FileExists(t, filepath.Join("project-path", "go.mod"))
+	})
+
+	t.Run("fails when the clone fails without cache", func(t *testing.T) {
+		isolateUserDirs(t)
+
+		mockProcess := mockNewProcess()
+		mockResult := mocksprocess.NewResult(t)
+		mockResult.EXPECT().Failed().Return(true).Once()
+		mockResult.EXPECT().Error().Return(assert.AnError).Once()
+		mockProcess.EXPECT().Run("git", "clone", "--depth=1", repo, "project-path").Return(mockResult).Once()
+
+		err := newCommand.fetchTemplate(context.Background(), source, "project-path", templateFetchOptions{Transport: transportGit})
+		assert.ErrorContains(t, err, "failed to clone goravel")
+	})
+}
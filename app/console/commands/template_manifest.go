@@ -0,0 +1,110 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/goravel/framework/support/color"
+	"github.com/goravel/framework/support/env"
+	"go.yaml.in/yaml/v3"
+)
+
+// templateManifestFile is the installer manifest a template can ship in its root directory.
+const templateManifestFile = "goravel-installer.yaml"
+
+// templateManifest describes how the installer sets up a project created from a template.
+type templateManifest struct {
+	Hooks     templateHooks      `yaml:"hooks"`
+	Variables []templateVariable `yaml:"variables"`
+	// Render are the glob patterns of the files rendered with the variables, e.g. "*.md" or "config/app.go".
+	Render []string `yaml:"render"`
+	// ModuleRename are the rules of the other files the module path is renamed in, e.g. a Helm chart.
+	ModuleRename []moduleRenameRule `yaml:"module_rename"`
+}
+
+// templateHooks are shell commands run in the project directory, pre hooks run before the dependencies are
+// installed and post hooks once the project is set up.
+type templateHooks struct {
+	Pre  []string `yaml:"pre"`
+	Post []string `yaml:"post"`
+}
+
+// Empty Report whether the template doesn't declare any hook.
+func (r templateHooks) Empty() bool {
+	return len(r.Pre) == 0 && len(r.Post) == 0
+}
+
+// readTemplateManifest Read the manifest of the template in the path and remove it from the project, an empty
+// manifest is returned when the template doesn't ship one.
+func readTemplateManifest(path string) (templateManifest, error) {
+	var manifest templateManifest
+
+	manifestPath := filepath.Join(path, templateManifestFile)
+	content, err := os.ReadFile(manifestPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return manifest, nil
+		}
+
+		return manifest, fmt.Errorf("failed to read %s: %s", templateManifestFile, err)
+	}
+
+	if err := yaml.Unmarshal(content, &manifest); err != nil {
+		return manifest, fmt.Errorf("failed to parse %s: %s", templateManifestFile, err)
+	}
+	if err := validateModuleRenameRules(manifest.ModuleRename); err != nil {
+		return manifest, fmt.Errorf("failed to parse %s: %s", templateManifestFile, err)
+	}
+	if err := os.Remove(manifestPath); err != nil {
+		return manifest, fmt.Errorf("failed to remove %s: %s", templateManifestFile, err)
+	}
+
+	return manifest, nil
+}
+
+// isTrustedTemplate Report whether the hooks of the template can run without asking, the official templates and the
+// ones in the new.trusted_templates configuration are trusted.
+func isTrustedTemplate(location string, trusted []string) bool {
+	normalize := func(location string) string {
+		return strings.TrimSuffix(strings.TrimRight(strings.TrimSpace(location), "/"), ".git")
+	}
+
+	location = normalize(location)
+	for _, template := range append([]string{goravelRepo, goravelLiteRepo}, trusted...) {
+		if template = normalize(template); template != "" && template == location {
+			return true
+		}
+	}
+
+	return false
+}
+
+// parseTrustedTemplates Split the comma-separated new.trusted_templates configuration.
+func parseTrustedTemplates(value string) []string {
+	var templates []string
+	for _, template := range strings.Split(value, ",") {
+		if template = strings.TrimSpace(template); template != "" {
+			templates = append(templates, template)
+		}
+	}
+
+	return templates
+}
+
+// runHook Run a hook command with the shell of the platform in the project directory.
+func runHook(path, stage, command string, vars map[string]string) error {
+	shell, flag := "sh", "-c"
+	if env.IsWindows() {
+		shell, flag = "cmd", "/C"
+	}
+
+	if res := newProcess().Env(vars).Path(path).Run(shell, flag, command); res.Failed() {
+		return fmt.Errorf("the %s hook %q failed: %s", stage, command, res.Error())
+	}
+
+	color.Successln(fmt.Sprintf("Ran the %s hook %q", stage, command))
+
+	return nil
+}
@@ -0,0 +1,82 @@
+package commands
+
+import (
+	"path/filepath"
+	"testing"
+
+	mocksprocess "github.com/goravel/framework/mocks/process"
+	"github.com/goravel/framework/support/env"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadTemplateManifest(t *testing.T) {
+	t.Run("without manifest", func(t *testing.T) {
+		manifest, err := readTemplateManifest(t.TempDir())
+		assert.Nil(t, err)
+		assert.True(t, manifest.Hooks.Empty())
+	})
+
+	t.Run("with hooks", func(t *testing.T) {
+		path := t.TempDir()
+		writeFile(t, filepath.Join(path, templateManifestFile), "hooks:\n  pre:\n    - go generate ./...\n  post:\n    - rm -rf app/examples\n")
+
+		manifest, err := readTemplateManifest(path)
+		assert.Nil(t, err)
+		assert.Equal(t, templateHooks{Pre: []string{"go generate ./..."}, Post: []string{"rm -rf app/examples"}}, manifest.Hooks)
+		assert.NoFileExists(t, filepath.Join(path, templateManifestFile))
+	})
+
+	t.Run("with module rename rules", func(t *testing.T) {
+		path := t.TempDir()
+		writeFile(t, filepath.Join(path, templateManifestFile), "module_rename:\n  - files: [\"*.yaml\"]\n  - files: [Makefile]\n    pattern: \"(MODULE := ){module}()\"\n")
+
+		manifest, err := readTemplateManifest(path)
+		assert.Nil(t, err)
+		assert.Equal(t, []moduleRenameRule{{Files: []string{"*.yaml"}}, {Files: []string{"Makefile"}, Pattern: "(MODULE := ){module}()"}}, manifest.ModuleRename)
+	})
+
+	t.Run("invalid module rename rule", func(t *testing.T) {
+		path := t.TempDir()
+		writeFile(t, filepath.Join(path, templateManifestFile), "module_rename:\n  - files: [Makefile]\n    pattern: MODULE\n")
+
+		_, err := readTemplateManifest(path)
+		assert.EqualError(t, err, `failed to parse `+templateManifestFile+`: the module rename pattern "MODULE" doesn't contain {module}`)
+	})
+
+	t.Run("invalid manifest", func(t *testing.T) {
+		path := t.TempDir()
+		writeFile(t, filepath.Join(path, templateManifestFile), "hooks: [")
+
+		_, err := readTemplateManifest(path)
+		assert.ErrorContains(t, err, "failed to parse "+templateManifestFile)
+	})
+}
+
+func TestIsTrustedTemplate(t *testing.T) {
+	trusted := parseTrustedTemplates("https://github.com/acme/skeleton, , /srv/templates/api/")
+
+	assert.Equal(t, []string{"https://github.com/acme/skeleton", "/srv/templates/api/"}, trusted)
+	assert.True(t, isTrustedTemplate(goravelRepo, nil))
+	assert.True(t, isTrustedTemplate("https://github.com/goravel/goravel-lite", nil))
+	assert.True(t, isTrustedTemplate("https://github.com/acme/skeleton.git", trusted))
+	assert.True(t, isTrustedTemplate("/srv/templates/api", trusted))
+	assert.False(t, isTrustedTemplate("https://github.com/acme/other.git", trusted))
+}
+
+func TestRunHook(t *testing.T) {
+	shell, flag := "sh", "-c"
+	if env.IsWindows() {
+		shell, flag = "cmd", "/C"
+	}
+	vars := map[string]string{"GORAVEL_MODULE": "github.com/acme/blog"}
+
+	mockProcess := mockNewProcess()
+	mockProcess.EXPECT().Env(vars).Return(mockProcess).Once()
+	mockProcess.EXPECT().Path("project").Return(mockProcess).Once()
+	mockResult := mocksprocess.NewResult(t)
+	mockResult.EXPECT().Failed().Return(true).Once()
+	mockResult.EXPECT().Error().Return(assert.AnError).Once()
+	mockProcess.EXPECT().Run(shell, flag, "go generate ./...").Return(mockResult).Once()
+
+	assert.ErrorContains(t, runHook("project", "pre", "go generate ./...", vars), `the pre hook "go generate ./..." failed`)
+}
@@ -0,0 +1,124 @@
+package commands
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseTemplateSource(t *testing.T) {
+	tmpDir := t.TempDir()
+	tarball := filepath.Join(tmpDir, "skeleton.tar.gz")
+	assert.Nil(t, os.WriteFile(tarball, []byte(""), 0644))
+	unsupported := filepath.Join(tmpDir, "skeleton.txt")
+	assert.Nil(t, os.WriteFile(unsupported, []byte(""), 0644))
+
+	tests := []struct {
+		name     string
+		template string
+		expected templateSource
+		err      string
+	}{
+		{name: "https url", template: "https://github.com/acme/skeleton.git", expected: templateSource{Kind: templateKindGit, Location: "https://github.com/acme/skeleton.git"}},
+		{name: "file url", template: "file:///srv/git/skeleton.git", expected: templateSource{Kind: templateKindGit, Location: "file:///srv/git/skeleton.git"}},
+		{name: "scp-like url", template: "git@github.com:acme/skeleton.git", expected: templateSource{Kind: templateKindGit, Location: "git@github.com:acme/skeleton.git"}},
+		{name: "directory", template: tmpDir, expected: templateSource{Kind: templateKindDirectory, Location: tmpDir}},
+		{name: "tarball", template: tarball, expected: templateSource{Kind: templateKindArchive, Location: tarball}},
+		{name: "archive url", template: "https://example.com/skeleton.zip", expected: templateSource{Kind: templateKindArchive, Location: "https://example.com/skeleton.zip"}},
+		{name: "empty", template: " ", err: "the template is required"},
+		{name: "missing", template: filepath.Join(tmpDir, "missing"), err: "does not exist"},
+		{name: "unsupported file", template: unsupported, err: "unsupported template"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			source, err := parseTemplateSource(test.template)
+			if test.err != "" {
+				assert.ErrorContains(t, err, test.err)
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, test.expected, source)
+		})
+	}
+}
+
+func TestCopyTemplateDirectory(t *testing.T) {
+	source := t.TempDir()
+	assert.Nil(t, os.MkdirAll(filepath.Join(source, ".git"), 0755))
+	assert.Nil(t, os.WriteFile(filepath.Join(source, ".git", "HEAD"), []byte("ref: refs/heads/main"), 0644))
+	assert.Nil(t, os.MkdirAll(filepath.Join(source, "app"), 0755))
+	assert.Nil(t, os.WriteFile(filepath.Join(source, "app", "app.go"), []byte("package app"), 0644))
+	assert.Nil(t, os.WriteFile(filepath.Join(source, "go.mod"), []byte("module goravel\n"), 0644))
+
+	path := filepath.Join(t.TempDir(), "project")
+	assert.Nil(t, copyTemplateDirectory(source, path))
+
+	assert.FileExists(t, filepath.Join(path, "go.mod"))
+	assert.FileExists(t, filepath.Join(path, "app", "app.go"))
+	assert.NoDirExists(t, filepath.Join(path, ".git"))
+}
+
+func TestExtractTemplateArchive(t *testing.T) {
+	t.Run("strips the single top-level directory", func(t *testing.T) {
+		archive := filepath.Join(t.TempDir(), "skeleton.tar.gz")
+		writeTarball(t, archive, map[string]string{
+			"skeleton-main/go.mod":     "module goravel\n",
+			"skeleton-main/app/app.go": "package app",
+		})
+
+		path := filepath.Join(t.TempDir(), "project")
+		assert.Nil(t, extractTemplateArchive(context.Background(), archive, "", path))
+
+		content, err := os.ReadFile(filepath.Join(path, "go.mod"))
+		assert.Nil(t, err)
+		assert.Equal(t, "module goravel\n", string(content))
+		assert.FileExists(t, filepath.Join(path, "app", "app.go"))
+	})
+
+	t.Run("rejects path traversal", func(t *testing.T) {
+		archive := filepath.Join(t.TempDir(), "skeleton.tar.gz")
+		writeTarball(t, archive, map[string]string{
+			"../evil.go": "package evil",
+		})
+
+		path := filepath.Join(t.TempDir(), "project")
+		assert.ErrorContains(t, extractTemplateArchive(context.Background(), archive, "", path), `illegal archive entry "../evil.go"`)
+		assert.NoDirExists(t, path)
+	})
+}
+
+func writeTarball(t *testing.T, path string, files map[string]string) {
+	t.Helper()
+
+	f, err := os.Create(path)
+	if err != nil {
+		t.Fatalf("os.Create(%q) = %v, want nil", path, err)
+	}
+	defer func() {
+		_ = f.Close()
+	}()
+
+	gzipWriter := gzip.NewWriter(f)
+	tarWriter := tar.NewWriter(gzipWriter)
+	for name, content := range files {
+		if err := tarWriter.WriteHeader(&tar.Header{Name: name, Mode: 0644, Size: int64(len(content)), Typeflag: tar.TypeReg}); err != nil {
+			t.Fatalf("tarWriter.WriteHeader(%q) = %v, want nil", name, err)
+		}
+		if _, err := tarWriter.Write([]byte(content)); err != nil {
+			t.Fatalf("tarWriter.Write(%q) = %v, want nil", name, err)
+		}
+	}
+	if err := tarWriter.Close(); err != nil {
+		t.Fatalf("tarWriter.Close() = %v, want nil", err)
+	}
+	if err := gzipWriter.Close(); err != nil {
+		t.Fatalf("gzipWriter.Close() = %v, want nil", err)
+	}
+}
@@ -0,0 +1,285 @@
+package commands
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/goravel/framework/contracts/console"
+	"github.com/goravel/framework/support/color"
+)
+
+const (
+	templateVariableString = "string"
+	templateVariableInt    = "int"
+	templateVariableBool   = "bool"
+	templateVariableChoice = "choice"
+)
+
+var templateVariableTypes = []string{templateVariableString, templateVariableInt, templateVariableBool, templateVariableChoice}
+
+var templateVariableNameRegexp = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+
+// templateVariable is a value a template asks for, it's rendered in the files matched by the render patterns of
+// the manifest.
+type templateVariable struct {
+	Name     string   `yaml:"name"`
+	Prompt   string   `yaml:"prompt"`
+	Type     string   `yaml:"type"`
+	Default  string   `yaml:"default"`
+	Options  []string `yaml:"options"`
+	Pattern  string   `yaml:"pattern"`
+	Required bool     `yaml:"required"`
+}
+
+// Validate Verify the declaration of the variable, its default value has to be valid as well.
+func (r templateVariable) Validate() error {
+	if !templateVariableNameRegexp.MatchString(r.Name) {
+		return fmt.Errorf("invalid variable name %q, use letters, numbers and underscores", r.Name)
+	}
+	if !slices.Contains(templateVariableTypes, r.Type) {
+		return fmt.Errorf("invalid type %q of the variable %s, use one of: %s", r.Type, r.Name, strings.Join(templateVariableTypes, ", "))
+	}
+	if r.Type == templateVariableChoice && len(r.Options) == 0 {
+		return fmt.Errorf("the choice variable %s has no options", r.Name)
+	}
+	if r.Pattern != "" {
+		if _, err := regexp.Compile(r.Pattern); err != nil {
+			return fmt.Errorf("invalid pattern of the variable %s: %s", r.Name, err)
+		}
+	}
+	if r.Default != "" {
+		if _, err := r.Parse(r.Default); err != nil {
+			return fmt.Errorf("invalid default value of the variable %s: %s", r.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// Parse Convert the value to the type of the variable and validate it, an empty optional value is the zero value of
+// the type.
+func (r templateVariable) Parse(value string) (any, error) {
+	if value == "" {
+		if r.Required {
+			return nil, errors.New("the value is required")
+		}
+
+		switch r.Type {
+		case templateVariableInt:
+			return 0, nil
+		case templateVariableBool:
+			return false, nil
+		default:
+			return value, nil
+		}
+	}
+
+	switch r.Type {
+	case templateVariableInt:
+		number, err := strconv.Atoi(value)
+		if err != nil {
+			return nil, fmt.Errorf("%q is not an integer", value)
+		}
+
+		return number, nil
+	case templateVariableBool:
+		boolean, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, fmt.Errorf("%q is not a boolean, use true or false", value)
+		}
+
+		return boolean, nil
+	case templateVariableChoice:
+		if !slices.Contains(r.Options, value) {
+			return nil, fmt.Errorf("%q is not one of: %s", value, strings.Join(r.Options, ", "))
+		}
+	}
+
+	if r.Pattern != "" && !regexp.MustCompile(r.Pattern).MatchString(value) {
+		return nil, fmt.Errorf("%q doesn't match the pattern %s", value, r.Pattern)
+	}
+
+	return value, nil
+}
+
+// parseTemplateVars Parse the --var key=value options.
+func parseTemplateVars(vars []string) (map[string]string, error) {
+	values := make(map[string]string)
+	for _, variable := range vars {
+		key, value, ok := strings.Cut(variable, "=")
+		if key = strings.TrimSpace(key); !ok || key == "" {
+			return nil, fmt.Errorf("invalid variable %q, use key=value", variable)
+		}
+		values[key] = value
+	}
+
+	return values, nil
+}
+
+// resolveTemplateVariables Get the values of the variables declared by the template, they come from the --var options,
+// the answers to the prompts or the default values when no question can be asked.
+func resolveTemplateVariables(ctx console.Context, variables []templateVariable, vars map[string]string, noInteraction bool) (map[string]any, error) {
+	for name := range vars {
+		if !slices.ContainsFunc(variables, func(variable templateVariable) bool {
+			return variable.Name == name
+		}) {
+			return nil, fmt.Errorf("the template doesn't declare the variable %s", name)
+		}
+	}
+
+	values := make(map[string]any)
+	for _, variable := range variables {
+		if variable.Type == "" {
+			variable.Type = templateVariableString
+		}
+		if err := variable.Validate(); err != nil {
+			return nil, fmt.Errorf("invalid %s: %s", templateManifestFile, err)
+		}
+
+		value, ok := vars[variable.Name]
+		if !ok && noInteraction {
+			value, ok = variable.Default, true
+		}
+		if ok {
+			parsed, err := variable.Parse(value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value of the variable %s: %s", variable.Name, err)
+			}
+			values[variable.Name] = parsed
+			continue
+		}
+
+		parsed, err := askTemplateVariable(ctx, variable)
+		if err != nil {
+			return nil, err
+		}
+		values[variable.Name] = parsed
+	}
+
+	return values, nil
+}
+
+func askTemplateVariable(ctx console.Context, variable templateVariable) (any, error) {
+	question := variable.Prompt
+	if question == "" {
+		question = "What is the value of " + variable.Name + "?"
+	}
+
+	switch variable.Type {
+	case templateVariableBool:
+		defaultValue, _ := strconv.ParseBool(variable.Default)
+
+		return ctx.Confirm(question, console.ConfirmOption{Default: defaultValue}), nil
+	case templateVariableChoice:
+		options := make([]console.Choice, len(variable.Options))
+		for i, option := range variable.Options {
+			options[i] = console.Choice{Key: option, Value: option}
+		}
+
+		value, err := ctx.Choice(question, options, console.ChoiceOption{Default: variable.Default})
+		if err != nil {
+			return nil, err
+		}
+
+		return variable.Parse(value)
+	}
+
+	value, err := ctx.Ask(question, console.AskOption{
+		Default: variable.Default,
+		Prompt:  "> ",
+		Validate: func(value string) error {
+			_, err := variable.Parse(value)
+
+			return err
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return variable.Parse(value)
+}
+
+// renderTemplateFiles Render the files of the project that match the patterns with text/template, a pattern without a
+// slash matches the file name in any directory. The rendered files are returned relative to the path.
+func renderTemplateFiles(projectPath string, patterns []string, data map[string]any) ([]string, error) {
+	var rendered []string
+	err := filepath.WalkDir(projectPath, func(filePath string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if entry.IsDir() {
+			if filePath != projectPath && slices.Contains(moduleRenameSkippedDirs, entry.Name()) {
+				return filepath.SkipDir
+			}
+
+			return nil
+		}
+
+		relativePath, err := filepath.Rel(projectPath, filePath)
+		if err != nil {
+			return err
+		}
+		relativePath = filepath.ToSlash(relativePath)
+		if !slices.ContainsFunc(patterns, func(pattern string) bool {
+			if !strings.Contains(pattern, "/") {
+				matched, _ := path.Match(pattern, path.Base(relativePath))
+				return matched
+			}
+			matched, _ := path.Match(pattern, relativePath)
+			return matched
+		}) {
+			return nil
+		}
+
+		if err := renderTemplateFile(filePath, data); err != nil {
+			return fmt.Errorf("failed to render %s: %s", relativePath, err)
+		}
+		rendered = append(rendered, relativePath)
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(rendered) > 0 {
+		color.Successln(fmt.Sprintf("Rendered the template variables in %d files", len(rendered)))
+	}
+
+	return rendered, nil
+}
+
+func renderTemplateFile(filePath string, data map[string]any) error {
+	content, err := os.ReadFile(filePath)
+	if err != nil {
+		return err
+	}
+
+	tmpl, err := template.New(filepath.Base(filePath)).Option("missingkey=error").Parse(string(content))
+	if err != nil {
+		return err
+	}
+
+	var buffer bytes.Buffer
+	if err := tmpl.Execute(&buffer, data); err != nil {
+		return err
+	}
+
+	info, err := os.Stat(filePath)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(filePath, buffer.Bytes(), info.Mode().Perm())
+}
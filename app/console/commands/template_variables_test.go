@@ -0,0 +1,145 @@
+package commands
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/goravel/framework/contracts/console"
+	mocksconsole "github.com/goravel/framework/mocks/console"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestTemplateVariableParse(t *testing.T) {
+	tests := []struct {
+		name     string
+		variable templateVariable
+		value    string
+		expected any
+		err      string
+	}{
+		{name: "string", variable: templateVariable{Name: "title", Type: "string"}, value: "Blog", expected: "Blog"},
+		{name: "empty string", variable: templateVariable{Name: "title", Type: "string"}, value: "", expected: ""},
+		{name: "required", variable: templateVariable{Name: "title", Type: "string", Required: true}, value: "", err: "the value is required"},
+		{name: "pattern", variable: templateVariable{Name: "slug", Type: "string", Pattern: "^[a-z-]+$"}, value: "My Blog", err: `"My Blog" doesn't match the pattern ^[a-z-]+$`},
+		{name: "int", variable: templateVariable{Name: "port", Type: "int"}, value: "8080", expected: 8080},
+		{name: "invalid int", variable: templateVariable{Name: "port", Type: "int"}, value: "http", err: `"http" is not an integer`},
+		{name: "empty int", variable: templateVariable{Name: "port", Type: "int"}, value: "", expected: 0},
+		{name: "required int", variable: templateVariable{Name: "port", Type: "int", Required: true}, value: "", err: "the value is required"},
+		{name: "bool", variable: templateVariable{Name: "auth", Type: "bool"}, value: "true", expected: true},
+		{name: "invalid bool", variable: templateVariable{Name: "auth", Type: "bool"}, value: "maybe", err: `"maybe" is not a boolean, use true or false`},
+		{name: "empty bool", variable: templateVariable{Name: "auth", Type: "bool"}, value: "", expected: false},
+		{name: "choice", variable: templateVariable{Name: "ui", Type: "choice", Options: []string{"vue", "react"}}, value: "vue", expected: "vue"},
+		{name: "invalid choice", variable: templateVariable{Name: "ui", Type: "choice", Options: []string{"vue", "react"}}, value: "svelte", err: `"svelte" is not one of: vue, react`},
+		{name: "empty choice", variable: templateVariable{Name: "ui", Type: "choice", Options: []string{"vue", "react"}}, value: "", expected: ""},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			value, err := test.variable.Parse(test.value)
+			if test.err != "" {
+				assert.EqualError(t, err, test.err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, test.expected, value)
+		})
+	}
+}
+
+func TestTemplateVariableValidate(t *testing.T) {
+	assert.Nil(t, templateVariable{Name: "app_port", Type: "int", Default: "8080"}.Validate())
+	assert.EqualError(t, templateVariable{Name: "app-port", Type: "int"}.Validate(), `invalid variable name "app-port", use letters, numbers and underscores`)
+	assert.EqualError(t, templateVariable{Name: "port", Type: "float"}.Validate(), `invalid type "float" of the variable port, use one of: string, int, bool, choice`)
+	assert.EqualError(t, templateVariable{Name: "ui", Type: "choice"}.Validate(), "the choice variable ui has no options")
+	assert.ErrorContains(t, templateVariable{Name: "slug", Type: "string", Pattern: "["}.Validate(), "invalid pattern of the variable slug")
+	assert.EqualError(t, templateVariable{Name: "port", Type: "int", Default: "http"}.Validate(), `invalid default value of the variable port: "http" is not an integer`)
+}
+
+func TestParseTemplateVars(t *testing.T) {
+	vars, err := parseTemplateVars([]string{"app_port=8080", "title=My=Blog", "empty="})
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]string{"app_port": "8080", "title": "My=Blog", "empty": ""}, vars)
+
+	_, err = parseTemplateVars([]string{"app_port"})
+	assert.EqualError(t, err, `invalid variable "app_port", use key=value`)
+}
+
+func TestResolveTemplateVariables(t *testing.T) {
+	variables := []templateVariable{
+		{Name: "title", Prompt: "What is the title?", Default: "Blog"},
+		{Name: "port", Type: "int", Default: "8080"},
+		{Name: "auth", Type: "bool", Prompt: "Enable auth?", Default: "true"},
+		{Name: "ui", Type: "choice", Prompt: "Which UI?", Options: []string{"vue", "react"}, Default: "vue"},
+	}
+
+	t.Run("ask", func(t *testing.T) {
+		mockContext := mocksconsole.NewContext(t)
+		mockContext.EXPECT().Ask("What is the title?", mock.MatchedBy(func(option console.AskOption) bool {
+			return option.Default == "Blog" && option.Validate("") == nil
+		})).Return("Shop", nil).Once()
+		mockContext.EXPECT().Confirm("Enable auth?", console.ConfirmOption{Default: true}).Return(false).Once()
+		mockContext.EXPECT().Choice("Which UI?", []console.Choice{{Key: "vue", Value: "vue"}, {Key: "react", Value: "react"}}, console.ChoiceOption{Default: "vue"}).Return("react", nil).Once()
+
+		values, err := resolveTemplateVariables(mockContext, variables, map[string]string{"port": "9000"}, false)
+		assert.Nil(t, err)
+		assert.Equal(t, map[string]any{"title": "Shop", "port": 9000, "auth": false, "ui": "react"}, values)
+	})
+
+	t.Run("no interaction", func(t *testing.T) {
+		values, err := resolveTemplateVariables(mocksconsole.NewContext(t), variables, map[string]string{"ui": "react"}, true)
+		assert.Nil(t, err)
+		assert.Equal(t, map[string]any{"title": "Blog", "port": 8080, "auth": true, "ui": "react"}, values)
+	})
+
+	t.Run("required without default", func(t *testing.T) {
+		_, err := resolveTemplateVariables(mocksconsole.NewContext(t), []templateVariable{{Name: "title", Required: true}}, nil, true)
+		assert.EqualError(t, err, "invalid value of the variable title: the value is required")
+	})
+
+	t.Run("invalid value", func(t *testing.T) {
+		_, err := resolveTemplateVariables(mocksconsole.NewContext(t), variables, map[string]string{"port": "http"}, true)
+		assert.EqualError(t, err, `invalid value of the variable port: "http" is not an integer`)
+	})
+
+	t.Run("undeclared variable", func(t *testing.T) {
+		_, err := resolveTemplateVariables(mocksconsole.NewContext(t), variables, map[string]string{"theme": "dark"}, true)
+		assert.EqualError(t, err, "the template doesn't declare the variable theme")
+	})
+
+	t.Run("invalid declaration", func(t *testing.T) {
+		_, err := resolveTemplateVariables(mocksconsole.NewContext(t), []templateVariable{{Name: "port", Type: "float"}}, nil, true)
+		assert.EqualError(t, err, `invalid goravel-installer.yaml: invalid type "float" of the variable port, use one of: string, int, bool, choice`)
+	})
+}
+
+func TestRenderTemplateFiles(t *testing.T) {
+	path := t.TempDir()
+	writeFile(t, filepath.Join(path, "README.md"), "# {{ .title }}\n")
+	writeFile(t, filepath.Join(path, "docs/index.md"), "{{ .project_name }} on port {{ .port }}\n")
+	writeFile(t, filepath.Join(path, "config/app.go"), "package config\n\n// {{ .module }}\n")
+	writeFile(t, filepath.Join(path, "main.go"), "package main\n\n// {{ .title }}\n")
+	writeFile(t, filepath.Join(path, "vendor/pkg/README.md"), "{{ .title }}\n")
+	data := map[string]any{"title": "Blog", "port": 8080, "project_name": "blog", "module": "github.com/acme/blog"}
+
+	rendered, err := renderTemplateFiles(path, []string{"*.md", "config/*.go"}, data)
+	assert.Nil(t, err)
+	assert.ElementsMatch(t, []string{"README.md", "docs/index.md", "config/app.go"}, rendered)
+
+	for file, expected := range map[string]string{
+		"README.md":            "# Blog\n",
+		"docs/index.md":        "blog on port 8080\n",
+		"config/app.go":        "package config\n\n// github.com/acme/blog\n",
+		"main.go":              "package main\n\n// {{ .title }}\n",
+		"vendor/pkg/README.md": "{{ .title }}\n",
+	} {
+		content, err := os.ReadFile(filepath.Join(path, file))
+		assert.Nil(t, err)
+		assert.Equal(t, expected, string(content), file)
+	}
+
+	writeFile(t, filepath.Join(path, "CHANGELOG.md"), "{{ .version }}\n")
+	_, err = renderTemplateFiles(path, []string{"CHANGELOG.md"}, data)
+	assert.ErrorContains(t, err, "failed to render CHANGELOG.md")
+}
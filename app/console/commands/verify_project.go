@@ -0,0 +1,86 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	contractsprocess "github.com/goravel/framework/contracts/process"
+	"github.com/goravel/framework/support/color"
+)
+
+// verifyOutputLines is the number of output lines kept in the summary of a failed verification.
+const verifyOutputLines = 10
+
+// errProjectVerification is wrapped by the error of a failed verification, the command exits with a non-zero status
+// then, so --verify can be used in scripts.
+var errProjectVerification = errors.New("the project failed the verification")
+
+// verifyOptions describes how a new project is verified once it's generated.
+type verifyOptions struct {
+	Tests bool
+}
+
+// Commands Get the arguments of the go commands that verify the project, in order.
+func (r verifyOptions) Commands() [][]string {
+	commands := [][]string{{"vet", "./..."}, {"build", "./..."}}
+	if r.Tests {
+		commands = append(commands, []string{"test", "./..."})
+	}
+
+	return commands
+}
+
+// verifyProject Run the verification commands in the project, the first failing one stops the verification. The
+// project is kept, so the failure can be inspected.
+func verifyProject(path string, options projectOptions, plan *projectPlan) error {
+	// A project that isn't one of the modules of a parent workspace can only be built without the workspace mode
+	if options.Workspace == "" && findGoWork(filepath.Dir(path)) != "" {
+		defer disableGoWorkspace()()
+	}
+
+	for _, args := range options.Verify.Commands() {
+		command := formatCommand("go", args...)
+		if err := options.Events.Step("verify_"+args[0], "Run "+command, func() error {
+			return plan.Run(options.GoModule.Command(args...), func() error {
+				if res := options.GoModule.Process(path, "Running "+command).Run("go", args...); res.Failed() {
+					return fmt.Errorf("%w at `%s`, it's kept in %s for inspection:\n%s", errProjectVerification, command, path, summarizeProcessOutput(res))
+				}
+
+				color.Successln("Verified the project with " + command)
+
+				return nil
+			})
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// summarizeProcessOutput Get the first lines of the output of a failed process, stderr is preferred. The lines of the
+// packages that passed the tests are left out.
+func summarizeProcessOutput(res contractsprocess.Result) string {
+	output := strings.TrimSpace(res.ErrorOutput())
+	if output == "" {
+		output = strings.TrimSpace(res.Output())
+	}
+	if output == "" {
+		return "  " + res.Error().Error()
+	}
+
+	var lines []string
+	for _, line := range strings.Split(output, "\n") {
+		if strings.HasPrefix(line, "ok  \t") || strings.HasPrefix(line, "?   \t") {
+			continue
+		}
+		lines = append(lines, line)
+	}
+	if len(lines) > verifyOutputLines {
+		lines = append(lines[:verifyOutputLines], fmt.Sprintf("... %d more line(s)", len(lines)-verifyOutputLines))
+	}
+
+	return "  " + strings.Join(lines, "\n  ")
+}
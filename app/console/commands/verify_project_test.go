@@ -0,0 +1,93 @@
+package commands
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	mocksprocess "github.com/goravel/framework/mocks/process"
+	"github.com/goravel/framework/support/color"
+	frameworkmock "github.com/goravel/framework/testing/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVerifyOptionsCommands(t *testing.T) {
+	assert.Equal(t, [][]string{{"vet", "./..."}, {"build", "./..."}}, verifyOptions{}.Commands())
+	assert.Equal(t, [][]string{{"vet", "./..."}, {"build", "./..."}, {"test", "./..."}}, verifyOptions{Tests: true}.Commands())
+}
+
+func TestVerifyProject(t *testing.T) {
+	t.Run("runs every command", func(t *testing.T) {
+		path := t.TempDir()
+		mockProcess := mockNewProcess()
+		for _, args := range [][]any{{"vet", "./..."}, {"build", "./..."}, {"test", "./..."}} {
+			mockProcess.EXPECT().WithSpinner("Running go " + args[0].(string) + " ./...").Return(mockProcess).Once()
+			mockProcess.EXPECT().Path(path).Return(mockProcess).Once()
+			mockResult := mocksprocess.NewResult(t)
+			mockResult.EXPECT().Failed().Return(false).Once()
+			mockProcess.EXPECT().Run("go", args...).Return(mockResult).Once()
+		}
+
+		var err error
+		captureOutput := color.CaptureOutput(func(w io.Writer) {
+			err = verifyProject(path, projectOptions{Verify: &verifyOptions{Tests: true}}, nil)
+		})
+		assert.Nil(t, err)
+		assert.Contains(t, captureOutput, "Verified the project with go test ./...")
+	})
+
+	t.Run("stops at the failing command", func(t *testing.T) {
+		path := t.TempDir()
+		mockProcess := mockNewProcess()
+		mockProcess.EXPECT().WithSpinner("Running go vet ./...").Return(mockProcess).Once()
+		mockProcess.EXPECT().Path(path).Return(mockProcess).Once()
+		mockResult := mocksprocess.NewResult(t)
+		mockResult.EXPECT().Failed().Return(true).Once()
+		mockResult.EXPECT().ErrorOutput().Return("# github.com/acme/blog/app\napp/main.go:3:2: undefined: goravel\n").Once()
+		mockProcess.EXPECT().Run("go", "vet", "./...").Return(mockResult).Once()
+
+		var err error
+		color.CaptureOutput(func(w io.Writer) {
+			err = verifyProject(path, projectOptions{Verify: &verifyOptions{}}, nil)
+		})
+		assert.EqualError(t, err, "the project failed the verification at `go vet ./...`, it's kept in "+path+" for inspection:\n"+
+			"  # github.com/acme/blog/app\n  app/main.go:3:2: undefined: goravel")
+		assert.ErrorIs(t, err, errProjectVerification)
+	})
+
+	t.Run("records the commands during a dry run", func(t *testing.T) {
+		frameworkmock.Factory().Process()
+		plan := newProjectPlan()
+
+		assert.Nil(t, verifyProject(t.TempDir(), projectOptions{GoModule: goModuleOptions{Proxy: "off"}, Verify: &verifyOptions{}}, plan))
+		assert.Equal(t, []string{"Run `GOPROXY=off go vet ./...`", "Run `GOPROXY=off go build ./...`"}, plan.steps)
+	})
+}
+
+func TestSummarizeProcessOutput(t *testing.T) {
+	t.Run("keeps the failing packages of go test", func(t *testing.T) {
+		mockResult := mocksprocess.NewResult(t)
+		mockResult.EXPECT().ErrorOutput().Return("").Once()
+		mockResult.EXPECT().Output().Return("ok  \tgithub.com/acme/blog/app\t0.1s\n--- FAIL: TestIndex (0.00s)\nFAIL\tgithub.com/acme/blog/tests\t0.2s\n").Once()
+
+		assert.Equal(t, "  --- FAIL: TestIndex (0.00s)\n  FAIL\tgithub.com/acme/blog/tests\t0.2s", summarizeProcessOutput(mockResult))
+	})
+
+	t.Run("truncates a long output", func(t *testing.T) {
+		mockResult := mocksprocess.NewResult(t)
+		mockResult.EXPECT().ErrorOutput().Return(strings.Repeat("undefined: goravel\n", 15)).Once()
+
+		summary := summarizeProcessOutput(mockResult)
+		assert.Equal(t, 10, strings.Count(summary, "undefined: goravel"))
+		assert.True(t, strings.HasSuffix(summary, "... 5 more line(s)"))
+	})
+
+	t.Run("falls back to the error", func(t *testing.T) {
+		mockResult := mocksprocess.NewResult(t)
+		mockResult.EXPECT().ErrorOutput().Return("").Once()
+		mockResult.EXPECT().Output().Return("").Once()
+		mockResult.EXPECT().Error().Return(assert.AnError).Once()
+
+		assert.Equal(t, "  "+assert.AnError.Error(), summarizeProcessOutput(mockResult))
+	})
+}
@@ -0,0 +1,167 @@
+package commands
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/goravel/framework/contracts/console"
+	"github.com/goravel/framework/contracts/console/command"
+	"github.com/goravel/framework/support/color"
+	"golang.org/x/mod/semver"
+
+	"github.com/goravel/installer/app/facades"
+)
+
+type VersionsCommand struct{}
+
+func NewVersionsCommand() *VersionsCommand {
+	return &VersionsCommand{}
+}
+
+// Signature The name and signature of the console command.
+func (r *VersionsCommand) Signature() string {
+	return "versions"
+}
+
+// Description The console command description.
+func (r *VersionsCommand) Description() string {
+	return "List the available versions of the Goravel templates"
+}
+
+// Extend The console command extend.
+func (r *VersionsCommand) Extend() command.Extend {
+	return command.Extend{
+		Flags: []command.Flag{
+			&command.StringFlag{
+				Name:  "type",
+				Usage: "Specify the project type of the catalog, e.g. goravel or lite. Defaults to the first type of the catalog",
+			},
+			&command.StringFlag{
+				Name:    "template",
+				Aliases: []string{"t"},
+				Usage:   "List the versions of a custom starter template git repository",
+			},
+			&command.StringFlag{
+				Name:  "mirror",
+				Usage: "The comma-separated mirrors to list the versions from: github, gitee or an HTTP(S) base URL, the next one is tried when a listing fails",
+			},
+		},
+	}
+}
+
+// Handle Execute the console command.
+func (r *VersionsCommand) Handle(ctx console.Context) error {
+	config, err := loadInstallerConfig()
+	if err != nil {
+		color.Errorln(err)
+		return nil
+	}
+
+	repo, err := r.getRepo(ctx, config)
+	if err != nil {
+		color.Errorln(err)
+		return nil
+	}
+
+	mirrors, err := getMirrors(ctx.Option("mirror"), config)
+	if err != nil {
+		color.Errorln(err)
+		return nil
+	}
+
+	versions, err := listVersions(repo, mirrors)
+	if err != nil {
+		color.Errorln(err)
+		return nil
+	}
+	if len(versions) == 0 {
+		color.Warnln("No versions found in " + repo)
+		return nil
+	}
+
+	color.Green().Printfln("Available versions of %s:", repo)
+	for _, version := range versions {
+		color.Printfln("%s", version)
+	}
+
+	return nil
+}
+
+func (r *VersionsCommand) getRepo(ctx console.Context, config *installerConfig) (string, error) {
+	if template := ctx.Option("template"); template != "" {
+		source, err := parseTemplateSource(template)
+		if err != nil {
+			return "", err
+		}
+		if source.Kind != templateKindGit {
+			return "", fmt.Errorf("template %q is not a git repository", template)
+		}
+
+		return source.Location, nil
+	}
+
+	catalog := loadProjectCatalog(config.Get("new.catalog"))
+	projectType, err := catalog.Find(cmp.Or(ctx.Option("type"), catalog.Types[0].Name))
+	if err != nil {
+		return "", err
+	}
+
+	source, err := parseTemplateSource(projectType.Repo)
+	if err != nil {
+		return "", err
+	}
+	if source.Kind != templateKindGit {
+		return "", fmt.Errorf("the repo of the project type %s is not a git repository", projectType.Name)
+	}
+
+	return source.Location, nil
+}
+
+// listVersions List the tags of a repository from its mirrors, semantic versions come first, newest first.
+func listVersions(repo string, mirrors []string) ([]string, error) {
+	if !gitInstalled() {
+		return nil, errors.New("git is required to list versions, please install it first")
+	}
+
+	var output string
+	if err := fetchFromMirrors(repo, mirrors, func(repo string, fallback bool) error {
+		res := abortStalledClone(facades.Process(), fallback).Quietly().WithSpinner("Fetching versions").Run("git", "ls-remote", "--tags", "--refs", repo)
+		if res.Failed() {
+			return fmt.Errorf("failed to list versions: %v", res.Error())
+		}
+		output = res.Output()
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	var versions []string
+	for _, line := range strings.Split(output, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) != 2 || !strings.HasPrefix(fields[1], "refs/tags/") {
+			continue
+		}
+
+		versions = append(versions, strings.TrimPrefix(fields[1], "refs/tags/"))
+	}
+
+	slices.SortStableFunc(versions, func(a, b string) int {
+		aValid, bValid := semver.IsValid(a), semver.IsValid(b)
+		switch {
+		case aValid && bValid:
+			return semver.Compare(b, a)
+		case aValid:
+			return -1
+		case bValid:
+			return 1
+		default:
+			return strings.Compare(a, b)
+		}
+	})
+
+	return versions, nil
+}
@@ -0,0 +1,162 @@
+package commands
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/goravel/framework/contracts/console/command"
+	mocksconsole "github.com/goravel/framework/mocks/console"
+	mocksprocess "github.com/goravel/framework/mocks/process"
+	"github.com/goravel/framework/support/color"
+	frameworkmock "github.com/goravel/framework/testing/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVersionsCommand(t *testing.T) {
+	versionsCommand := NewVersionsCommand()
+	isolateUserDirs(t)
+	t.Setenv(mirrorEnv, "")
+
+	t.Run("list versions", func(t *testing.T) {
+		setGitInstalled(t, true)
+		mockProcess := frameworkmock.Factory().Process()
+		mockProcess.EXPECT().Quietly().Return(mockProcess).Once()
+		mockProcess.EXPECT().WithSpinner("Fetching versions").Return(mockProcess).Once()
+		mockResult := mocksprocess.NewResult(t)
+		mockResult.EXPECT().Failed().Return(false).Once()
+		mockResult.EXPECT().Output().Return("a1\trefs/tags/v1.9.0\nb2\trefs/tags/nightly\nc3\trefs/tags/v1.16.0\nd4\trefs/tags/v1.16.1\n").Once()
+		mockProcess.EXPECT().Run("git", "ls-remote", "--tags", "--refs", goravelLiteRepo).Return(mockResult).Once()
+
+		mockContext := mocksconsole.NewContext(t)
+		mockContext.EXPECT().Option("template").Return("").Once()
+		mockContext.EXPECT().Option("type").Return("lite").Once()
+		mockContext.EXPECT().Option("mirror").Return("").Once()
+
+		captureOutput := color.CaptureOutput(func(w io.Writer) {
+			assert.Nil(t, versionsCommand.Handle(mockContext))
+		})
+
+		assert.Contains(t, captureOutput, "Available versions of "+goravelLiteRepo+":")
+		assert.Contains(t, captureOutput, "v1.16.1\nv1.16.0\nv1.9.0\nnightly\n")
+	})
+
+	t.Run("list versions of a custom template", func(t *testing.T) {
+		setGitInstalled(t, true)
+		repo := "https://github.com/acme/skeleton.git"
+		mockProcess := frameworkmock.Factory().Process()
+		mockProcess.EXPECT().Quietly().Return(mockProcess).Once()
+		mockProcess.EXPECT().WithSpinner("Fetching versions").Return(mockProcess).Once()
+		mockResult := mocksprocess.NewResult(t)
+		mockResult.EXPECT().Failed().Return(false).Once()
+		mockResult.EXPECT().Output().Return("").Once()
+		mockProcess.EXPECT().Run("git", "ls-remote", "--tags", "--refs", repo).Return(mockResult).Once()
+
+		mockContext := mocksconsole.NewContext(t)
+		mockContext.EXPECT().Option("template").Return(repo).Once()
+		mockContext.EXPECT().Option("mirror").Return("").Once()
+
+		captureOutput := color.CaptureOutput(func(w io.Writer) {
+			assert.Nil(t, versionsCommand.Handle(mockContext))
+		})
+
+		assert.Contains(t, captureOutput, "No versions found in "+repo)
+	})
+
+	t.Run("list versions from a mirror", func(t *testing.T) {
+		setGitInstalled(t, true)
+		mirrored := "https://gitee.com/goravel/goravel.git"
+		lowSpeed := map[string]string{"GIT_HTTP_LOW_SPEED_LIMIT": "1000", "GIT_HTTP_LOW_SPEED_TIME": "30"}
+		mockProcess := frameworkmock.Factory().Process()
+		mockProcess.EXPECT().Env(lowSpeed).Return(mockProcess).Once()
+		mockProcess.EXPECT().Quietly().Return(mockProcess).Twice()
+		mockProcess.EXPECT().WithSpinner("Fetching versions").Return(mockProcess).Twice()
+		mockFailedResult := mocksprocess.NewResult(t)
+		mockFailedResult.EXPECT().Failed().Return(true).Once()
+		mockFailedResult.EXPECT().Error().Return(assert.AnError).Once()
+		mockProcess.EXPECT().Run("git", "ls-remote", "--tags", "--refs", mirrored).Return(mockFailedResult).Once()
+		mockResult := mocksprocess.NewResult(t)
+		mockResult.EXPECT().Failed().Return(false).Once()
+		mockResult.EXPECT().Output().Return("a1\trefs/tags/v1.16.0\n").Once()
+		mockProcess.EXPECT().Run("git", "ls-remote", "--tags", "--refs", goravelRepo).Return(mockResult).Once()
+
+		mockContext := mocksconsole.NewContext(t)
+		mockContext.EXPECT().Option("template").Return("").Once()
+		mockContext.EXPECT().Option("type").Return("goravel").Once()
+		mockContext.EXPECT().Option("mirror").Return("gitee").Once()
+
+		captureOutput := color.CaptureOutput(func(w io.Writer) {
+			assert.Nil(t, versionsCommand.Handle(mockContext))
+		})
+
+		assert.Contains(t, captureOutput, "failed to list versions: "+assert.AnError.Error()+", trying the next mirror "+goravelRepo)
+		assert.Contains(t, captureOutput, "Available versions of "+goravelRepo+":")
+		assert.Contains(t, captureOutput, "v1.16.0\n")
+	})
+
+	t.Run("list versions of the first type of a custom catalog", func(t *testing.T) {
+		// The type flag has no default, so the first type of the catalog is used
+		for _, flag := range versionsCommand.Extend().Flags {
+			if stringFlag, ok := flag.(*command.StringFlag); ok && stringFlag.Name == "type" {
+				assert.Empty(t, stringFlag.Value)
+			}
+		}
+
+		setGitInstalled(t, true)
+		catalogPath := filepath.Join(t.TempDir(), "catalog.yaml")
+		writeFile(t, catalogPath, "types:\n  - name: api\n    repo: https://github.com/acme/goravel-api.git\n")
+		userPath, err := userInstallerConfigPath()
+		assert.Nil(t, err)
+		writeFile(t, userPath, "new:\n  catalog: "+catalogPath+"\n")
+		t.Cleanup(func() {
+			_ = os.Remove(userPath)
+		})
+
+		mockProcess := frameworkmock.Factory().Process()
+		mockProcess.EXPECT().Quietly().Return(mockProcess).Once()
+		mockProcess.EXPECT().WithSpinner("Fetching versions").Return(mockProcess).Once()
+		mockResult := mocksprocess.NewResult(t)
+		mockResult.EXPECT().Failed().Return(false).Once()
+		mockResult.EXPECT().Output().Return("a1\trefs/tags/v1.0.0\n").Once()
+		mockProcess.EXPECT().Run("git", "ls-remote", "--tags", "--refs", "https://github.com/acme/goravel-api.git").Return(mockResult).Once()
+
+		mockContext := mocksconsole.NewContext(t)
+		mockContext.EXPECT().Option("template").Return("").Once()
+		mockContext.EXPECT().Option("type").Return("").Once()
+		mockContext.EXPECT().Option("mirror").Return("").Once()
+
+		captureOutput := color.CaptureOutput(func(w io.Writer) {
+			assert.Nil(t, versionsCommand.Handle(mockContext))
+		})
+
+		assert.Contains(t, captureOutput, "Available versions of https://github.com/acme/goravel-api.git:")
+	})
+
+	t.Run("git is not installed", func(t *testing.T) {
+		setGitInstalled(t, false)
+
+		mockContext := mocksconsole.NewContext(t)
+		mockContext.EXPECT().Option("template").Return("").Once()
+		mockContext.EXPECT().Option("type").Return("").Once()
+		mockContext.EXPECT().Option("mirror").Return("").Once()
+
+		captureOutput := color.CaptureOutput(func(w io.Writer) {
+			assert.Nil(t, versionsCommand.Handle(mockContext))
+		})
+
+		assert.Contains(t, captureOutput, "git is required to list versions")
+	})
+
+	t.Run("invalid type", func(t *testing.T) {
+		mockContext := mocksconsole.NewContext(t)
+		mockContext.EXPECT().Option("template").Return("").Once()
+		mockContext.EXPECT().Option("type").Return("full").Once()
+
+		captureOutput := color.CaptureOutput(func(w io.Writer) {
+			assert.Nil(t, versionsCommand.Handle(mockContext))
+		})
+
+		assert.Contains(t, captureOutput, `invalid project type "full"`)
+	})
+}
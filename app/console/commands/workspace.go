@@ -0,0 +1,67 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/goravel/framework/support/color"
+	"github.com/goravel/framework/support/file"
+)
+
+// findGoWork Find the go.work of the workspace that contains the directory, an empty string is returned when there
+// is none.
+func findGoWork(dir string) string {
+	for {
+		if goWork := filepath.Join(dir, "go.work"); file.Exists(goWork) {
+			return goWork
+		}
+
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return ""
+		}
+		dir = parent
+	}
+}
+
+// disableGoWorkspace Turn off the workspace mode of the go commands until the returned function is called. The
+// project is built outside of the workspace modules, go refuses to run in it otherwise.
+func disableGoWorkspace() func() {
+	value, ok := os.LookupEnv("GOWORK")
+	_ = os.Setenv("GOWORK", "off")
+
+	return func() {
+		if ok {
+			_ = os.Setenv("GOWORK", value)
+		} else {
+			_ = os.Unsetenv("GOWORK")
+		}
+	}
+}
+
+// goWorkUseArgs Get the arguments of the go command that adds the project to the workspace.
+func goWorkUseArgs(goWork, path string) ([]string, error) {
+	relativePath, err := filepath.Rel(filepath.Dir(goWork), path)
+	if err != nil {
+		return nil, err
+	}
+
+	return []string{"work", "use", "./" + filepath.ToSlash(relativePath)}, nil
+}
+
+// useGoWorkspace Add the project in the path to the workspace of the go.work.
+func useGoWorkspace(goWork, path string) error {
+	args, err := goWorkUseArgs(goWork, path)
+	if err != nil {
+		return fmt.Errorf("failed to add the project to %s: %s", goWork, err)
+	}
+
+	if res := newProcess().Quietly().Path(filepath.Dir(goWork)).Run("go", args...); res.Failed() {
+		return fmt.Errorf("failed to add the project to %s: %s", goWork, res.Error())
+	}
+
+	color.Successln("Added the project to the Go workspace " + goWork)
+
+	return nil
+}
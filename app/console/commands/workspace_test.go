@@ -0,0 +1,42 @@
+package commands
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFindGoWork(t *testing.T) {
+	root := t.TempDir()
+	writeFile(t, filepath.Join(root, "go.work"), "go 1.25\n")
+	assert.Nil(t, os.MkdirAll(filepath.Join(root, "services", "billing"), 0755))
+
+	assert.Equal(t, filepath.Join(root, "go.work"), findGoWork(filepath.Join(root, "services", "billing")))
+	assert.Equal(t, filepath.Join(root, "go.work"), findGoWork(filepath.Join(root, "services", "missing")))
+	assert.Equal(t, "", findGoWork(t.TempDir()))
+}
+
+func TestGoWorkUseArgs(t *testing.T) {
+	root := t.TempDir()
+
+	args, err := goWorkUseArgs(filepath.Join(root, "go.work"), filepath.Join(root, "services", "billing"))
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"work", "use", "./services/billing"}, args)
+}
+
+func TestDisableGoWorkspace(t *testing.T) {
+	t.Setenv("GOWORK", "/srv/go.work")
+
+	restore := disableGoWorkspace()
+	assert.Equal(t, "off", os.Getenv("GOWORK"))
+	restore()
+	assert.Equal(t, "/srv/go.work", os.Getenv("GOWORK"))
+
+	assert.Nil(t, os.Unsetenv("GOWORK"))
+	restore = disableGoWorkspace()
+	restore()
+	_, ok := os.LookupEnv("GOWORK")
+	assert.False(t, ok)
+}
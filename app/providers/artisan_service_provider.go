@@ -35,10 +35,16 @@ func (r *ArtisanServiceProvider) Register(app foundation.Application) {
 func (r *ArtisanServiceProvider) Boot(app foundation.Application) {
 	artisanFacade := app.MakeArtisan()
 	artisanFacade.Register([]contractsconsole.Command{
+		commands.NewCacheClearCommand(),
+		commands.NewConfigGetCommand(),
+		commands.NewConfigListCommand(),
+		commands.NewConfigSetCommand(),
+		commands.NewDoctorCommand(),
 		commands.NewNewCommand(),
 		commands.NewSkillInstallCommand(),
 		commands.NewSkillListCommand(),
 		commands.NewUpgradeCommand(),
+		commands.NewVersionsCommand(),
 	})
 }
 
@@ -12,7 +12,7 @@ func Boot() contractsfoundation.Application {
 		WithConfig(config.Boot).
 		WithProviders(Providers).
 		WithCommandsFilter(func() []string {
-			return []string{"list", "new", "skill:install", "skill:list", "upgrade"}
+			return []string{"cache:clear", "config:get", "config:list", "config:set", "doctor", "list", "new", "skill:install", "skill:list", "upgrade", "versions"}
 		}).
 		Create()
 }